@@ -0,0 +1,88 @@
+package ai
+
+import (
+	"math/rand"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// spellWorld is implemented by worlds where spells can be cast
+type spellWorld interface {
+	CastSpell(spell, level int, ch *types.Character, arg string, spellType int, victim *types.Character, obj *types.ObjectInstance) error
+}
+
+// CombatProcs is a map of special procedure names to functions that can
+// take a mobile's turn in a combat round. They are called with the mobile
+// and its opponent and return true if they took the turn.
+var CombatProcs = map[string]func(mob, victim *types.Character) bool{
+	"breath_fire":      dragonBreath(types.SPELL_FIRE_BREATH),
+	"breath_gas":       dragonBreath(types.SPELL_GAS_BREATH),
+	"breath_frost":     dragonBreath(types.SPELL_FROST_BREATH),
+	"breath_acid":      dragonBreath(types.SPELL_ACID_BREATH),
+	"breath_lightning": dragonBreath(types.SPELL_LIGHTNING_BREATH),
+	"breath_any":       dragonBreathAny,
+}
+
+// CombatProcVnums assigns combat procedures to mobiles by vnum
+var CombatProcVnums = map[int]string{
+	6112: "breath_gas",  // the huge, green dragon
+	7040: "breath_fire", // the red dragon
+}
+
+// HandleCombatProcs gives a mobile's combat procedure, if it has one, the
+// chance to take its turn against victim. Returns true if it did.
+func HandleCombatProcs(mob, victim *types.Character) bool {
+	if !mob.IsNPC || mob.Prototype == nil {
+		return false
+	}
+
+	name, ok := CombatProcVnums[mob.Prototype.VNUM]
+	if !ok {
+		return false
+	}
+
+	proc, ok := CombatProcs[name]
+	return ok && proc(mob, victim)
+}
+
+// dragonBreath returns a combat procedure that breathes spell at the
+// mobile's opponent about one round in three
+func dragonBreath(spell int) func(mob, victim *types.Character) bool {
+	return func(mob, victim *types.Character) bool {
+		if rand.Intn(3) != 0 {
+			return false
+		}
+		return breathe(mob, victim, spell)
+	}
+}
+
+// dragonBreathAny is the combat procedure for dragons of no one colour,
+// who breathe whatever comes up, frost and lightning most often
+func dragonBreathAny(mob, victim *types.Character) bool {
+	if rand.Intn(3) != 0 {
+		return false
+	}
+
+	spells := []int{
+		types.SPELL_FIRE_BREATH,
+		types.SPELL_LIGHTNING_BREATH, types.SPELL_LIGHTNING_BREATH,
+		types.SPELL_GAS_BREATH,
+		types.SPELL_ACID_BREATH,
+		types.SPELL_FROST_BREATH, types.SPELL_FROST_BREATH, types.SPELL_FROST_BREATH,
+	}
+	return breathe(mob, victim, spells[rand.Intn(len(spells))])
+}
+
+// breathe has the mobile breathe spell at its opponent
+func breathe(mob, victim *types.Character, spell int) bool {
+	if victim == nil || mob.InRoom == nil || victim.InRoom != mob.InRoom {
+		return false
+	}
+
+	world, ok := mob.World.(spellWorld)
+	if !ok {
+		return false
+	}
+
+	return world.CastSpell(spell, mob.Level, mob, "", types.SPELL_TYPE_SPELL, victim, nil) == nil
+}
@@ -0,0 +1,50 @@
+package ai
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// mockSpellWorld records the spells cast in it
+type mockSpellWorld struct {
+	cast []int
+}
+
+func (w *mockSpellWorld) CastSpell(spell, level int, ch *types.Character, arg string, spellType int, victim *types.Character, obj *types.ObjectInstance) error {
+	w.cast = append(w.cast, spell)
+	return nil
+}
+
+func TestDragonBreathesOnOpponent(t *testing.T) {
+	world := &mockSpellWorld{}
+	room := &types.Room{VNUM: 7000}
+	dragon := &types.Character{Name: "dragon", IsNPC: true, Level: 30, InRoom: room, World: world,
+		Prototype: &types.Mobile{VNUM: 7040}}
+	victim := &types.Character{Name: "Victim", InRoom: room}
+
+	breathed := false
+	for i := 0; i < 100 && !breathed; i++ {
+		breathed = HandleCombatProcs(dragon, victim)
+	}
+	if !breathed {
+		t.Fatal("Expected the red dragon to breathe sooner or later")
+	}
+	for _, spell := range world.cast {
+		if spell != types.SPELL_FIRE_BREATH {
+			t.Errorf("Expected the red dragon to breathe fire, got spell %d", spell)
+		}
+	}
+
+	rabbit := &types.Character{Name: "rabbit", IsNPC: true, InRoom: room, World: world,
+		Prototype: &types.Mobile{VNUM: 1}}
+	world.cast = nil
+	for i := 0; i < 100; i++ {
+		if HandleCombatProcs(rabbit, victim) {
+			t.Fatal("Expected a rabbit to have no combat procedure")
+		}
+	}
+	if len(world.cast) != 0 {
+		t.Errorf("Expected nothing to be cast, got %v", world.cast)
+	}
+}
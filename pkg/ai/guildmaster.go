@@ -0,0 +1,162 @@
+package ai
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// guildmasterProc is the special procedure for guildmasters. It lets players
+// practice the skills and spells of their class.
+func guildmasterProc(mob, ch *types.Character, cmd, argument string) bool {
+	if cmd != "practice" || ch.IsNPC {
+		return false
+	}
+
+	// Guildmasters don't teach while fighting
+	if mob.Fighting != nil {
+		return false
+	}
+
+	// Spell casters learn spells, everyone else learns skills
+	casting := ch.Class == types.CLASS_MAGIC_USER || ch.Class == types.CLASS_CLERIC
+	learnable := guildLearnable(ch, casting)
+
+	argument = strings.ToLower(strings.Trim(strings.TrimSpace(argument), "'"))
+	if argument == "" {
+		listPractices(ch, casting, learnable)
+		return true
+	}
+
+	// Find what the character wants to practice
+	id := -1
+	for _, candidate := range learnable {
+		if strings.HasPrefix(strings.ToLower(guildAbilityName(candidate, casting)), argument) {
+			id = candidate
+			break
+		}
+	}
+	if id < 0 {
+		if casting {
+			ch.SendMessage("You do not know of this spell...\r\n")
+		} else {
+			ch.SendMessage("You do not know of this skill...\r\n")
+		}
+		return true
+	}
+
+	if ch.Practices <= 0 {
+		ch.SendMessage("You do not seem to be able to practice now.\r\n")
+		return true
+	}
+
+	learned := guildLearned(ch, id, casting)
+	maxLearn := types.GetClassMaxLearn(ch.Class)
+	if learned >= maxLearn {
+		ch.SendMessage("You are already learned in this area.\r\n")
+		return true
+	}
+
+	ch.SendMessage("You practice for a while...\r\n")
+	ch.Practices--
+
+	learned += types.GetLearnRate(ch.Abilities[types.GetClassLearnAbility(ch.Class)])
+	if learned > maxLearn {
+		learned = maxLearn
+	}
+	if casting {
+		if ch.Spells == nil {
+			ch.Spells = make(map[int]int)
+		}
+		ch.Spells[id] = learned
+	} else {
+		if ch.Skills == nil {
+			ch.Skills = make(map[int]int)
+		}
+		ch.Skills[id] = learned
+	}
+
+	if learned >= maxLearn {
+		ch.SendMessage("You are now learned in this area.\r\n")
+	}
+
+	return true
+}
+
+// listPractices shows the practice sessions left and what can be practiced
+func listPractices(ch *types.Character, casting bool, learnable []int) {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("You have %d practice sessions left.\r\n", ch.Practices))
+	if casting {
+		sb.WriteString("You can practice any of these spells:\r\n")
+	} else {
+		sb.WriteString("You can practice any of these skills:\r\n")
+	}
+
+	for _, id := range learnable {
+		sb.WriteString(fmt.Sprintf("%-20s %s\r\n",
+			strings.ToLower(guildAbilityName(id, casting)), howGood(guildLearned(ch, id, casting))))
+	}
+
+	ch.SendMessage(sb.String())
+}
+
+// guildLearnable returns the spells or skills the character can practice,
+// sorted by number
+func guildLearnable(ch *types.Character, casting bool) []int {
+	var learnable []int
+	if casting {
+		for id := range types.SpellData {
+			if types.GetSpellMinLevel(id, ch.Class) <= ch.Level {
+				learnable = append(learnable, id)
+			}
+		}
+	} else {
+		learnable = append(learnable, types.GetClassSkills(ch.Class)...)
+	}
+
+	sort.Ints(learnable)
+	return learnable
+}
+
+// guildAbilityName returns the name of a spell or skill
+func guildAbilityName(id int, casting bool) string {
+	if casting {
+		return types.GetSpellName(id)
+	}
+	return types.GetSkillName(id)
+}
+
+// guildLearned returns how well the character knows a spell or skill
+func guildLearned(ch *types.Character, id int, casting bool) int {
+	if casting {
+		return ch.Spells[id]
+	}
+	return ch.Skills[id]
+}
+
+// howGood describes a learned percentage
+func howGood(percent int) string {
+	switch {
+	case percent == 0:
+		return "(not learned)"
+	case percent <= 10:
+		return "(awful)"
+	case percent <= 20:
+		return "(bad)"
+	case percent <= 40:
+		return "(poor)"
+	case percent <= 55:
+		return "(average)"
+	case percent <= 70:
+		return "(fair)"
+	case percent <= 80:
+		return "(good)"
+	case percent <= 85:
+		return "(very good)"
+	default:
+		return "(superb)"
+	}
+}
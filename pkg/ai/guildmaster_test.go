@@ -0,0 +1,101 @@
+package ai
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// messageRecorder records the messages sent to characters
+type messageRecorder struct {
+	messages []string
+}
+
+func (m *messageRecorder) SendMessageToCharacter(ch *types.Character, message string) {
+	m.messages = append(m.messages, message)
+}
+
+func TestGuildmasterPractice(t *testing.T) {
+	recorder := &messageRecorder{}
+	room := &types.Room{VNUM: 3017}
+	master := &types.Character{Name: "guildmaster master", IsNPC: true, InRoom: room}
+	player := &types.Character{
+		Name:      "Thief",
+		Class:     types.CLASS_THIEF,
+		Level:     1,
+		Practices: 2,
+		Abilities: [6]int{types.ABILITY_INT: 16},
+		Skills:    map[int]int{types.SKILL_HIDE: 10},
+		InRoom:    room,
+		World:     recorder,
+	}
+	room.Characters = []*types.Character{master, player}
+
+	// Listing shows the sessions left and the class skills
+	if !HandleCommandProcs(player, "practice", "") {
+		t.Fatalf("Expected the guildmaster to handle practice")
+	}
+	listing := strings.Join(recorder.messages, "")
+	if !strings.Contains(listing, "You have 2 practice sessions left.") || !strings.Contains(listing, "hide") {
+		t.Errorf("Unexpected practice listing: %q", listing)
+	}
+	recorder.messages = nil
+
+	// Practicing raises the skill by the INT learn rate
+	HandleCommandProcs(player, "practice", "hide")
+	if player.Skills[types.SKILL_HIDE] != 10+types.GetLearnRate(16) {
+		t.Errorf("Expected hide to be %d, got %d", 10+types.GetLearnRate(16), player.Skills[types.SKILL_HIDE])
+	}
+	if player.Practices != 1 {
+		t.Errorf("Expected 1 practice session left, got %d", player.Practices)
+	}
+
+	// Skills are capped at the class maximum
+	player.Skills[types.SKILL_HIDE] = types.GetClassMaxLearn(types.CLASS_THIEF) - 1
+	HandleCommandProcs(player, "practice", "hide")
+	if player.Skills[types.SKILL_HIDE] != types.GetClassMaxLearn(types.CLASS_THIEF) {
+		t.Errorf("Expected hide to be capped at %d, got %d", types.GetClassMaxLearn(types.CLASS_THIEF), player.Skills[types.SKILL_HIDE])
+	}
+
+	// No sessions left
+	recorder.messages = nil
+	HandleCommandProcs(player, "practice", "sneak")
+	if player.Skills[types.SKILL_SNEAK] != 0 || !strings.Contains(strings.Join(recorder.messages, ""), "not seem to be able") {
+		t.Errorf("Expected practice to fail without sessions: %v", recorder.messages)
+	}
+
+	// Other classes' skills can't be practiced
+	player.Practices = 1
+	recorder.messages = nil
+	HandleCommandProcs(player, "practice", "bash")
+	if player.Practices != 1 || player.Skills[types.SKILL_BASH] != 0 {
+		t.Errorf("Expected a thief to be unable to practice bash")
+	}
+}
+
+func TestGuildmasterSpells(t *testing.T) {
+	room := &types.Room{VNUM: 3018}
+	master := &types.Character{Name: "guildmaster master", IsNPC: true, InRoom: room}
+	player := &types.Character{
+		Name:      "Cleric",
+		Class:     types.CLASS_CLERIC,
+		Level:     1,
+		Practices: 1,
+		Abilities: [6]int{types.ABILITY_WIS: 18},
+		InRoom:    room,
+	}
+	room.Characters = []*types.Character{master, player}
+
+	HandleCommandProcs(player, "practice", "'armor'")
+	if player.Spells[types.SPELL_ARMOR] != types.GetLearnRate(18) {
+		t.Errorf("Expected armor to be %d, got %d", types.GetLearnRate(18), player.Spells[types.SPELL_ARMOR])
+	}
+
+	// Spells above the character's level are unknown
+	player.Practices = 1
+	HandleCommandProcs(player, "practice", "teleport")
+	if player.Practices != 1 {
+		t.Errorf("Expected teleport to be unavailable at level 1")
+	}
+}
@@ -166,11 +166,19 @@ func (m *Manager) processWanderingBehavior(mobile *types.Character, elapsed time
 	}
 
 	// Get a random exit
-	nextRoom, _ := m.world.GetRandomExitRoom(mobile.InRoom)
+	nextRoom, dir := m.world.GetRandomExitRoom(mobile.InRoom)
 	if nextRoom == nil {
 		return
 	}
 
+	// Mobiles walk through exits and across terrain as players do
+	if exit := mobile.InRoom.Exits[dir]; exit != nil && exit.IsClosed() {
+		return
+	}
+	if types.CheckTerrain(mobile, nextRoom) != nil {
+		return
+	}
+
 	// Check if the mobile should stay in its zone
 	if mobile.Prototype.ActFlags&types.ACT_STAY_ZONE != 0 {
 		// Skip if the next room is not in the same zone
@@ -197,6 +205,11 @@ func (m *Manager) processAggressiveBehavior(mobile *types.Character) {
 	room := mobile.InRoom
 	room.RLock()
 
+	// Hidden players are safe from mobiles that can't see them
+	seer, _ := m.world.(interface {
+		CanSee(*types.Character, *types.Character) bool
+	})
+
 	// Find a player to attack
 	var target *types.Character
 	for _, character := range room.Characters {
@@ -204,6 +217,9 @@ func (m *Manager) processAggressiveBehavior(mobile *types.Character) {
 		if character.IsNPC || (mobile.Prototype.ActFlags&types.ACT_WIMPY != 0 && character.Position <= types.POS_SLEEPING) {
 			continue
 		}
+		if seer != nil && !seer.CanSee(mobile, character) {
+			continue
+		}
 		// Found a potential target
 		target = character
 		break // Attack the first valid target found
@@ -104,3 +104,36 @@ func TestMobAI(t *testing.T) {
 		t.Errorf("Expected mobile to move, but it didn't")
 	}
 }
+
+func TestWanderingMobsRespectTerrain(t *testing.T) {
+	room := &types.Room{VNUM: 1, Name: "Shore", SectorType: types.SECT_FIELD}
+	tests := []struct {
+		name   string
+		dest   *types.Room
+		closed bool
+		moves  bool
+	}{
+		{"field", &types.Room{VNUM: 2, Name: "Field", SectorType: types.SECT_FIELD}, false, true},
+		{"closed door", &types.Room{VNUM: 2, Name: "Field", SectorType: types.SECT_FIELD}, true, false},
+		{"deep water", &types.Room{VNUM: 2, Name: "Lake", SectorType: types.SECT_WATER_NOSWIM}, false, false},
+		{"underwater", &types.Room{VNUM: 2, Name: "Depths", SectorType: types.SECT_UNDERWATER}, false, false},
+		{"air", &types.Room{VNUM: 2, Name: "Sky", SectorType: types.SECT_FLYING}, false, false},
+	}
+
+	for _, tt := range tests {
+		room.Exits[0] = &types.Exit{DestVnum: 2}
+		if tt.closed {
+			room.Exits[0].Flags = types.EX_ISDOOR | types.EX_CLOSED
+		}
+		mobile := &types.Character{Name: "Wanderer", IsNPC: true, Prototype: &types.Mobile{VNUM: 1}, InRoom: room}
+		world := &MockWorld{mobiles: []*types.Character{mobile}, exitRoom: tt.dest}
+
+		manager := NewManager(world)
+		manager.lastTick = time.Now().Add(-10 * time.Minute) // Force a move
+		manager.Tick()
+
+		if moved := len(world.moveLog) > 0; moved != tt.moves {
+			t.Errorf("%s: expected moved to be %v, got %v", tt.name, tt.moves, moved)
+		}
+	}
+}
@@ -178,6 +178,8 @@ func buyPet(ch *types.Character, petRoom *types.Room, argument string) bool {
 
 	// Set up following relationship
 	newPet.Master = ch
+	newPet.Following = ch
+	ch.Followers = append(ch.Followers, newPet)
 	newPet.ActFlags |= types.ACT_FOLLOWER
 
 	// Messages
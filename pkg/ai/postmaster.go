@@ -0,0 +1,129 @@
+package ai
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// mailWorld is implemented by worlds with a post office
+type mailWorld interface {
+	Act(string, bool, *types.Character, *types.ObjectInstance, *types.Character, int)
+	CharacterExists(name string) bool
+	SendMail(from, to, text string) error
+	HasMail(name string) bool
+	ReceiveMail(name string) ([]*types.MailMessage, error)
+}
+
+// postmasterProc is the command procedure for postmasters. It lets players
+// send mail to each other, check for mail and receive it.
+func postmasterProc(mob, ch *types.Character, cmd, argument string) bool {
+	if (cmd != "mail" && cmd != "check" && cmd != "receive") || ch.IsNPC {
+		return false
+	}
+
+	world, ok := mob.World.(mailWorld)
+	if !ok {
+		return false
+	}
+
+	if mob.Position <= types.POS_SLEEPING {
+		world.Act("$e isn't able to talk to you...", false, mob, nil, ch, types.TO_VICT)
+		return true
+	}
+
+	switch cmd {
+	case "mail":
+		postmasterSendMail(world, mob, ch, argument)
+	case "check":
+		if world.HasMail(ch.Name) {
+			world.Act("$n tells you, 'You have mail waiting.'", false, mob, nil, ch, types.TO_VICT)
+		} else {
+			world.Act("$n tells you, 'Sorry, you don't have any mail waiting.'", false, mob, nil, ch, types.TO_VICT)
+		}
+	case "receive":
+		postmasterReceiveMail(world, mob, ch)
+	}
+
+	return true
+}
+
+// postmasterSendMail sells the character a stamp and lets them write a
+// letter to someone
+func postmasterSendMail(world mailWorld, mob, ch *types.Character, argument string) {
+	to := strings.TrimSpace(argument)
+	if to == "" {
+		world.Act("$n tells you, 'You need to specify an addressee!'", false, mob, nil, ch, types.TO_VICT)
+		return
+	}
+
+	if ch.Gold < types.STAMP_PRICE {
+		world.Act(fmt.Sprintf("$n tells you, 'A stamp costs %d coins.'", types.STAMP_PRICE), false, mob, nil, ch, types.TO_VICT)
+		world.Act("$n tells you, '...which I see you can't afford.'", false, mob, nil, ch, types.TO_VICT)
+		return
+	}
+
+	if !world.CharacterExists(to) {
+		world.Act("$n tells you, 'No one by that name is registered here!'", false, mob, nil, ch, types.TO_VICT)
+		return
+	}
+	to = strings.ToUpper(to[:1]) + strings.ToLower(to[1:])
+
+	ch.Gold -= types.STAMP_PRICE
+	world.Act("$n starts to write some mail.", true, ch, nil, nil, types.TO_ROOM)
+	world.Act(fmt.Sprintf("$n tells you, 'I'll take %d coins for the stamp.'", types.STAMP_PRICE), false, mob, nil, ch, types.TO_VICT)
+	world.Act("$n tells you, 'Write your message. Terminate with a @.'", false, mob, nil, ch, types.TO_VICT)
+
+	ch.StartEditor(types.MAX_MESSAGE_LENGTH, func(text string) {
+		if err := world.SendMail(ch.Name, to, text); err != nil {
+			log.Printf("Error sending mail from %s to %s: %v", ch.Name, to, err)
+			ch.SendMessage("The postmaster seems to have lost your letter.\r\n")
+			return
+		}
+		ch.SendMessage("Ok.\r\n")
+	})
+}
+
+// postmasterReceiveMail hands the character their letters
+func postmasterReceiveMail(world mailWorld, mob, ch *types.Character) {
+	mail, err := world.ReceiveMail(ch.Name)
+	if err != nil {
+		log.Printf("Error receiving mail for %s: %v", ch.Name, err)
+	}
+	if len(mail) == 0 {
+		world.Act("$n tells you, 'Sorry, you don't have any mail waiting.'", false, mob, nil, ch, types.TO_VICT)
+		return
+	}
+
+	for _, letter := range mail {
+		obj := newMailObject(letter)
+		obj.CarriedBy = ch
+		ch.Inventory = append(ch.Inventory, obj)
+
+		world.Act("$n gives you a piece of mail.", false, mob, nil, ch, types.TO_VICT)
+		world.Act("$N gives $n a piece of mail.", false, ch, nil, mob, types.TO_ROOM)
+	}
+}
+
+// newMailObject creates the note a letter is handed over as
+func newMailObject(letter *types.MailMessage) *types.ObjectInstance {
+	text := fmt.Sprintf(" * * * * Midgaard Mail System * * * *\r\nDate: %s\r\n  To: %s\r\nFrom: %s\r\n\r\n%s",
+		letter.Sent.Format("Mon Jan 2 15:04:05 2006"), letter.To, letter.From, letter.Text)
+
+	return &types.ObjectInstance{
+		Prototype: &types.Object{
+			VNUM:        -1,
+			Name:        "mail paper letter",
+			ShortDesc:   "a piece of mail",
+			Description: "Someone has left a piece of mail here.",
+			ActionDesc:  text,
+			Type:        types.ITEM_NOTE,
+			WearFlags:   types.ITEM_WEAR_TAKE | types.ITEM_WEAR_HOLD,
+			Weight:      1,
+		},
+		WornOn: -1,
+		Timer:  -1,
+	}
+}
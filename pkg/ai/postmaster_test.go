@@ -0,0 +1,82 @@
+package ai
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// mockMailWorld keeps mail in memory and records act messages
+type mockMailWorld struct {
+	messages []string
+	mail     map[string][]*types.MailMessage
+}
+
+func (w *mockMailWorld) Act(msg string, hide bool, ch *types.Character, obj *types.ObjectInstance, vict *types.Character, msgType int) {
+	w.messages = append(w.messages, msg)
+}
+
+func (w *mockMailWorld) CharacterExists(name string) bool {
+	return strings.EqualFold(name, "bob")
+}
+
+func (w *mockMailWorld) SendMail(from, to, text string) error {
+	w.mail[to] = append(w.mail[to], &types.MailMessage{From: from, To: to, Text: text})
+	return nil
+}
+
+func (w *mockMailWorld) HasMail(name string) bool {
+	return len(w.mail[name]) > 0
+}
+
+func (w *mockMailWorld) ReceiveMail(name string) ([]*types.MailMessage, error) {
+	mail := w.mail[name]
+	delete(w.mail, name)
+	return mail, nil
+}
+
+func TestPostmasterMail(t *testing.T) {
+	world := &mockMailWorld{mail: make(map[string][]*types.MailMessage)}
+	room := &types.Room{VNUM: 3070}
+	postmaster := &types.Character{Name: "postmaster", IsNPC: true, Position: types.POS_STANDING, InRoom: room, World: world}
+	alice := &types.Character{Name: "Alice", Gold: types.STAMP_PRICE, InRoom: room}
+	bob := &types.Character{Name: "Bob", InRoom: room}
+	room.Characters = []*types.Character{postmaster, alice, bob}
+
+	HandleCommandProcs(alice, "mail", "nobody")
+	if !strings.Contains(strings.Join(world.messages, "\n"), "No one by that name is registered here!") {
+		t.Errorf("Expected an unknown addressee to be refused, got %q", world.messages)
+	}
+
+	// Mailing costs a stamp and opens the editor
+	HandleCommandProcs(alice, "mail", "bob")
+	if alice.Gold != 0 || !alice.IsEditing() {
+		t.Fatalf("Expected Alice to pay for a stamp and write, gold %d", alice.Gold)
+	}
+	alice.EditLine("See you at the inn.@")
+	if len(world.mail["Bob"]) != 1 || world.mail["Bob"][0].From != "Alice" {
+		t.Fatalf("Expected a letter for Bob, got %v", world.mail)
+	}
+
+	world.messages = nil
+	HandleCommandProcs(bob, "check", "")
+	if !strings.Contains(world.messages[0], "You have mail waiting.") {
+		t.Errorf("Expected Bob to have mail, got %q", world.messages)
+	}
+
+	HandleCommandProcs(bob, "receive", "")
+	if len(bob.Inventory) != 1 || bob.Inventory[0].Prototype.Type != types.ITEM_NOTE {
+		t.Fatalf("Expected Bob to receive a note, got %v", bob.Inventory)
+	}
+	if text := bob.Inventory[0].Prototype.ActionDesc; !strings.Contains(text, "From: Alice") || !strings.Contains(text, "See you at the inn.") {
+		t.Errorf("Unexpected letter: %q", text)
+	}
+
+	// Without a stamp there is no mail
+	world.messages = nil
+	HandleCommandProcs(alice, "mail", "bob")
+	if alice.IsEditing() || !strings.Contains(strings.Join(world.messages, "\n"), "can't afford") {
+		t.Errorf("Expected Alice to be unable to afford a stamp, got %q", world.messages)
+	}
+}
@@ -0,0 +1,118 @@
+package ai
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// maxObjSave is the most objects a receptionist will store
+const maxObjSave = 30
+
+// minRentCost is what a receptionist charges per day however little is stored
+const minRentCost = 100
+
+// rentWorld is implemented by worlds that can store a character's objects
+type rentWorld interface {
+	Act(string, bool, *types.Character, *types.ObjectInstance, *types.Character, int)
+	RentCharacter(*types.Character, int) error
+}
+
+// receptionistCommandProc is the command procedure for receptionists. It
+// quotes the rent for a character's objects and stores them when they rent.
+func receptionistCommandProc(mob, ch *types.Character, cmd, argument string) bool {
+	if (cmd != "rent" && cmd != "offer") || ch.IsNPC {
+		return false
+	}
+
+	world, ok := mob.World.(rentWorld)
+	if !ok {
+		return false
+	}
+
+	if mob.Position <= types.POS_SLEEPING {
+		world.Act("$e isn't able to talk to you...", false, mob, nil, ch, types.TO_VICT)
+		return true
+	}
+
+	if cmd == "offer" {
+		recepOffer(world, mob, ch)
+		world.Act("$N gives $n an offer.", false, ch, nil, mob, types.TO_ROOM)
+		return true
+	}
+
+	cost, ok := recepOffer(world, mob, ch)
+	if !ok {
+		return true
+	}
+
+	world.Act("$n stores your stuff in the safe, and helps you into your chamber.", false, mob, nil, ch, types.TO_VICT)
+	world.Act("$n helps $N into $S private chamber.", false, mob, nil, ch, types.TO_NOTVICT)
+
+	if err := world.RentCharacter(ch, cost); err != nil {
+		log.Printf("Error renting %s: %v", ch.Name, err)
+		ch.SendMessage("Something went wrong with the safe, you keep your things.\r\n")
+		return true
+	}
+
+	ch.SendMessage("RETURN_TO_MENU")
+	return true
+}
+
+// recepOffer tells the character what storing their objects costs per day.
+// It returns the cost and whether the receptionist will store them.
+func recepOffer(world rentWorld, mob, ch *types.Character) (int, bool) {
+	cost := minRentCost
+	count := 0
+
+	var add func(obj *types.ObjectInstance) bool
+	add = func(obj *types.ObjectInstance) bool {
+		if obj.Prototype.ExtraFlags&types.ITEM_NORENT != 0 {
+			world.Act("$n tells you 'I refuse storing $p'", false, mob, obj, ch, types.TO_VICT)
+			return false
+		}
+		cost += max(0, obj.Prototype.RentCost)
+		count++
+		for _, content := range obj.Contains {
+			if !add(content) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, obj := range ch.Inventory {
+		if !add(obj) {
+			return 0, false
+		}
+	}
+	for _, obj := range ch.Equipment {
+		if obj != nil && !add(obj) {
+			return 0, false
+		}
+	}
+
+	if count == 0 {
+		world.Act("$n tells you 'But you are not carrying anything?'", false, mob, nil, ch, types.TO_VICT)
+		return 0, false
+	}
+
+	if count > maxObjSave {
+		world.Act(fmt.Sprintf("$n tells you 'Sorry, but I can't store any more than %d items.'", maxObjSave), false, mob, nil, ch, types.TO_VICT)
+		return 0, false
+	}
+
+	world.Act(fmt.Sprintf("$n tells you 'It will cost you %d coins per day'", cost), false, mob, nil, ch, types.TO_VICT)
+
+	if cost > ch.Gold {
+		if ch.Level < types.LEVEL_IMMORTAL {
+			world.Act("$n tells you 'Which I can see you can't afford'", false, mob, nil, ch, types.TO_VICT)
+			return 0, false
+		}
+		world.Act("$n tells you 'Well, since you're a God, I guess it's okay'", false, mob, nil, ch, types.TO_VICT)
+		cost = 0
+	}
+
+	return cost, true
+}
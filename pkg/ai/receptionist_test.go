@@ -0,0 +1,65 @@
+package ai
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// mockRentWorld records act messages and rented characters
+type mockRentWorld struct {
+	messages []string
+	rented   int
+}
+
+func (w *mockRentWorld) Act(msg string, hide bool, ch *types.Character, obj *types.ObjectInstance, vict *types.Character, msgType int) {
+	w.messages = append(w.messages, msg)
+}
+
+func (w *mockRentWorld) RentCharacter(ch *types.Character, cost int) error {
+	w.rented = cost
+	ch.Inventory = nil
+	return nil
+}
+
+func TestReceptionistRent(t *testing.T) {
+	world := &mockRentWorld{}
+	room := &types.Room{VNUM: 3008}
+	recep := &types.Character{Name: "receptionist", IsNPC: true, Position: types.POS_STANDING, InRoom: room, World: world}
+	torch := &types.ObjectInstance{Prototype: &types.Object{Name: "torch", RentCost: 10}}
+	player := &types.Character{Name: "Renter", Gold: 50, InRoom: room, Inventory: []*types.ObjectInstance{torch}}
+	room.Characters = []*types.Character{recep, player}
+
+	// The quote includes the minimum rent
+	if !HandleCommandProcs(player, "offer", "") {
+		t.Fatal("Expected the receptionist to handle offer")
+	}
+	if !strings.Contains(strings.Join(world.messages, "\n"), "It will cost you 110 coins per day") {
+		t.Errorf("Unexpected offer: %q", world.messages)
+	}
+
+	// Players who can't afford a day are turned away
+	HandleCommandProcs(player, "rent", "")
+	if world.rented != 0 || len(player.Inventory) != 1 {
+		t.Error("Expected a player who can't afford the rent to keep their things")
+	}
+
+	// No-rent objects are refused
+	player.Gold = 500
+	player.Inventory = append(player.Inventory, &types.ObjectInstance{Prototype: &types.Object{Name: "scroll", ExtraFlags: types.ITEM_NORENT}})
+	world.messages = nil
+	HandleCommandProcs(player, "rent", "")
+	if world.rented != 0 || !strings.Contains(strings.Join(world.messages, "\n"), "I refuse storing") {
+		t.Errorf("Expected the no-rent object to be refused, got %q", world.messages)
+	}
+
+	player.Inventory = player.Inventory[:1]
+	HandleCommandProcs(player, "rent", "")
+	if world.rented != 110 {
+		t.Errorf("Expected to rent at 110 coins per day, got %d", world.rented)
+	}
+	if !player.HasMessage("RETURN_TO_MENU") {
+		t.Error("Expected the renter to be logged out")
+	}
+}
@@ -1,6 +1,7 @@
 package ai
 
 import (
+	"fmt"
 	"log"
 	"strings"
 
@@ -23,6 +24,80 @@ var SpecialProcs = map[string]func(*types.Character, string) bool{
 	"petshop":      petShopProc,
 }
 
+// CommandProcs is a map of special procedure names to functions that respond
+// to commands typed by players in the same room as the mobile. They are
+// called with the mobile, the player, the command and its argument.
+var CommandProcs = map[string]func(mob, ch *types.Character, cmd, argument string) bool{
+	"guildmaster":  guildmasterProc,
+	"receptionist": receptionistCommandProc,
+	"postmaster":   postmasterProc,
+}
+
+// ReceiveProcs is a map of special procedure names to functions that react
+// to a mobile being given something. They are registered separately from
+// SpecialProcs so that giving never sets off a mobile's pulse behaviour.
+var ReceiveProcs = map[string]func(*types.Character, string) bool{}
+
+// HandleCommandProcs gives the mobiles in the character's room a chance to
+// handle a command. Returns true if one of them did.
+func HandleCommandProcs(ch *types.Character, cmd, argument string) bool {
+	if ch.InRoom == nil {
+		return false
+	}
+
+	ch.InRoom.RLock()
+	mobs := make([]*types.Character, 0, len(ch.InRoom.Characters))
+	for _, mob := range ch.InRoom.Characters {
+		if mob.IsNPC && mob != ch {
+			mobs = append(mobs, mob)
+		}
+	}
+	ch.InRoom.RUnlock()
+
+	for _, mob := range mobs {
+		for name, proc := range CommandProcs {
+			if strings.Contains(strings.ToLower(mob.Name), name) && proc(mob, ch, cmd, argument) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// HandleReceive lets a mobile's receive procedures react to being given
+// something, e.g. to hand out a quest reward or accept a bribe. The mobile
+// already holds what it was given when its procedures run. They are called
+// with the argument "give <giver> <object vnum>" for objects and
+// "give <giver> <amount> coins" for gold. A mobile's own receive procedures
+// are used if it has any, otherwise its prototype's. Returns true if a
+// procedure handled it.
+func HandleReceive(mob, giver *types.Character, obj *types.ObjectInstance, coins int) bool {
+	if !mob.IsNPC {
+		return false
+	}
+
+	var argument string
+	if obj != nil {
+		argument = fmt.Sprintf("give %s %d", giver.Name, obj.Prototype.VNUM)
+	} else {
+		argument = fmt.Sprintf("give %s %d coins", giver.Name, coins)
+	}
+
+	receivers := mob.Receivers
+	if len(receivers) == 0 && mob.Prototype != nil {
+		receivers = mob.Prototype.Receivers
+	}
+
+	for _, fn := range receivers {
+		if fn != nil && fn(mob, argument) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // cityguardProc is the special procedure for cityguards
 func cityguardProc(ch *types.Character, argument string) bool {
 	// Skip if the character is not an NPC
@@ -145,14 +220,7 @@ func receptionistProc(ch *types.Character, argument string) bool {
 		return false
 	}
 
-	// Handle rent commands
-	if strings.HasPrefix(argument, "rent") {
-		// TODO: Implement rent command
-		return true
-	} else if strings.HasPrefix(argument, "offer") {
-		// TODO: Implement offer command
-		return true
-	}
+	// Rent and offer are handled by receptionistCommandProc
 
 	// Use the receptionist behavior
 	return Behaviors["receptionist"](ch, nil)
@@ -179,5 +247,11 @@ func RegisterSpecialProcs(mobiles []*types.Mobile) {
 				mobile.Functions = append(mobile.Functions, proc)
 			}
 		}
+		for name, proc := range ReceiveProcs {
+			if strings.Contains(strings.ToLower(mobile.Name), name) {
+				log.Printf("Registering receive procedure %s for mobile %s", name, mobile.Name)
+				mobile.Receivers = append(mobile.Receivers, proc)
+			}
+		}
 	}
 }
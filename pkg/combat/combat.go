@@ -268,3 +268,22 @@ func (m *Manager) doAttack(attacker, defender *types.Character) {
 		}
 	}
 }
+
+// sendFileMessages shows the attacker, defender and room the messages for
+// a weapon attack from the world's messages file. It returns false if the
+// world has none, leaving the caller to describe the attack itself.
+func sendFileMessages(attacker, defender *types.Character, damage int, weaponType int) bool {
+	world, ok := attacker.World.(interface {
+		DamageMessages(*types.Character, *types.Character, int, int) bool
+	})
+	if !ok {
+		return false
+	}
+	return world.DamageMessages(attacker, defender, damage, types.WeaponAttackType(weaponType))
+}
+
+// isImmortal returns true if the character is an immortal player, whom no
+// attack can hurt
+func isImmortal(ch *types.Character) bool {
+	return !ch.IsNPC && ch.Level >= types.LEVEL_IMMORTAL
+}
@@ -343,6 +343,11 @@ func (m *DikuCombatManager) doAttack(attacker, defender *types.Character) {
 		damage = 1
 	}
 
+	// Immortals can't be hurt
+	if isImmortal(defender) {
+		damage = 0
+	}
+
 	// Apply the damage
 	defender.HP -= damage
 
@@ -384,7 +389,7 @@ func (m *DikuCombatManager) doAttack(attacker, defender *types.Character) {
 func getWeaponType(ch *types.Character) int {
 	if ch.Equipment[types.WEAR_WIELD] != nil {
 		// Use the weapon's type (value[3])
-		return ch.Equipment[types.WEAR_WIELD].Prototype.Value[3]
+		return types.WeaponType(ch.Equipment[types.WEAR_WIELD].Prototype.Value[3])
 	}
 	// Default to TYPE_HIT for bare hands
 	return types.TYPE_HIT
@@ -461,6 +466,10 @@ func getDexterityACBonus(dex int) int {
 
 // sendCombatMessage sends appropriate combat messages based on damage
 func sendCombatMessage(attacker, defender *types.Character, damage int, weaponType int) {
+	if sendFileMessages(attacker, defender, damage, weaponType) {
+		return
+	}
+
 	// Get the weapon verb
 	verb := getWeaponVerb(weaponType, damage > 0)
 
@@ -15,6 +15,10 @@ type EnhancedDikuCombatManager struct {
 	Combats map[string]*CombatState
 	// LastCombatTick is the last time combat was processed
 	LastCombatTick time.Time
+	// SpecialAttack, if set, gives a mobile the chance to do something other
+	// than attack on its turn, e.g. a dragon breathing fire. It returns true
+	// if it took the mobile's turn.
+	SpecialAttack func(attacker, defender *types.Character) bool
 }
 
 // CombatState represents the state of a character in combat
@@ -188,7 +192,16 @@ func (m *EnhancedDikuCombatManager) ProcessCombat() {
 
 	// Perform attacks for characters that can attack
 	for _, state := range charactersToAttack {
-		m.doAttack(state.Character, state.Target)
+		if state.Character.IsNPC && m.SpecialAttack != nil && m.SpecialAttack(state.Character, state.Target) {
+			m.specialAttackKills(state.Character)
+		} else {
+			m.doAttack(state.Character, state.Target)
+		}
+
+		// Wimpy characters run when they get hurt
+		if WantsToFlee(state.Target) {
+			Flee(state.Target, m)
+		}
 	}
 }
 
@@ -223,6 +236,11 @@ func (m *EnhancedDikuCombatManager) doAttack(attacker, defender *types.Character
 	// Calculate damage
 	damage := calculateDamage(attacker, defender)
 
+	// Immortals can't be hurt
+	if isImmortal(defender) {
+		damage = 0
+	}
+
 	// Apply damage
 	defender.HP -= damage
 
@@ -233,43 +251,122 @@ func (m *EnhancedDikuCombatManager) doAttack(attacker, defender *types.Character
 	if defender.HP <= 0 {
 		defender.HP = 0
 		defender.Position = types.POS_DEAD
+		m.killed(attacker, defender)
+	}
+}
+
+// specialAttackKills handles the deaths of anyone in the room a special
+// attack killed. A breath of gas can take down bystanders as well as the
+// mobile's opponent.
+func (m *EnhancedDikuCombatManager) specialAttackKills(attacker *types.Character) {
+	if attacker.InRoom == nil {
+		return
+	}
 
-		// Stop combat for both characters
+	attacker.InRoom.RLock()
+	var dead []*types.Character
+	for _, ch := range attacker.InRoom.Characters {
+		if ch != attacker && ch.Position == types.POS_DEAD {
+			dead = append(dead, ch)
+		}
+	}
+	attacker.InRoom.RUnlock()
+
+	for _, ch := range dead {
+		m.killed(attacker, ch)
+	}
+}
+
+// killed ends the fight after the attacker kills the defender, handles the
+// defender's death and awards the attacker experience
+func (m *EnhancedDikuCombatManager) killed(attacker, defender *types.Character) {
+	// Stop the defender's fight, and the attacker's if it was with them
+	m.StopCombat(defender)
+	if attacker.Fighting == defender {
 		m.StopCombat(attacker)
+	}
 
-		// Send death messages
-		attacker.SendMessage(fmt.Sprintf("You have slain %s!\r\n", defender.ShortDesc))
-		defender.SendMessage(fmt.Sprintf("%s has slain you!\r\n", attacker.ShortDesc))
+	// Send death messages
+	attacker.SendMessage(fmt.Sprintf("You have slain %s!\r\n", defender.ShortDesc))
+	defender.SendMessage(fmt.Sprintf("%s has slain you!\r\n", attacker.ShortDesc))
 
-		// Send a message to the room
-		for _, ch := range attacker.InRoom.Characters {
-			if ch != attacker && ch != defender {
-				ch.SendMessage(fmt.Sprintf("%s has slain %s!\r\n", attacker.ShortDesc, defender.ShortDesc))
-			}
+	// Send a message to the room
+	for _, ch := range attacker.InRoom.Characters {
+		if ch != attacker && ch != defender {
+			ch.SendMessage(fmt.Sprintf("%s has slain %s!\r\n", attacker.ShortDesc, defender.ShortDesc))
 		}
+	}
 
-		// Handle character death using the centralized death handler
-		w, ok := defender.World.(interface {
-			HandleCharacterDeath(*types.Character)
-		})
-		if ok {
-			w.HandleCharacterDeath(defender)
-		} else {
-			log.Printf("Warning: Could not handle death for %s", defender.Name)
-		}
+	// Handle character death using the centralized death handler
+	w, ok := defender.World.(interface {
+		HandleCharacterDeath(*types.Character)
+	})
+	if ok {
+		w.HandleCharacterDeath(defender)
+	} else {
+		log.Printf("Warning: Could not handle death for %s", defender.Name)
+	}
+
+	// Award experience
+	if !defender.IsNPC {
+		// PCs don't give experience
+		return
+	}
+
+	// Calculate experience
+	exp := calculateExperience(attacker, defender)
+
+	// Grouped characters share the experience with their group
+	if attacker.AffectedBy&types.AFF_GROUP != 0 {
+		groupGain(attacker, exp)
+		return
+	}
+
+	// Award experience, no more than a single gain can bring
+	exp = min(exp, types.MAX_EXP_GAIN)
+	attacker.SendMessage(fmt.Sprintf("You gain %d experience points.\r\n", exp))
+	awardExperience(attacker, exp)
+}
+
+// groupGain splits the experience for a kill among the grouped members of
+// the killer's group who are in the same room, in proportion to their level
+func groupGain(ch *types.Character, exp int) {
+	leader := ch
+	if ch.Following != nil {
+		leader = ch.Following
+	}
 
-		// Award experience
-		if !defender.IsNPC {
-			// PCs don't give experience
-			return
+	var members []*types.Character
+	for _, k := range append([]*types.Character{leader}, leader.Followers...) {
+		if k.AffectedBy&types.AFF_GROUP != 0 && k.InRoom == ch.InRoom {
+			members = append(members, k)
 		}
+	}
+
+	totalLevels := 0
+	for _, k := range members {
+		totalLevels += max(1, k.Level)
+	}
+	if totalLevels == 0 {
+		return
+	}
 
-		// Calculate experience
-		exp := calculateExperience(attacker, defender)
+	share := exp / totalLevels
+	for _, k := range members {
+		gain := min(share*max(1, k.Level), types.MAX_EXP_GAIN)
+		k.SendMessage(fmt.Sprintf("You receive your share of experience: %d points.\r\n", gain))
+		awardExperience(k, gain)
+	}
+}
 
-		// Award experience
-		attacker.Experience += exp
-		attacker.SendMessage(fmt.Sprintf("You gain %d experience points.\r\n", exp))
+// awardExperience gives a character experience through the world if possible
+func awardExperience(ch *types.Character, exp int) {
+	if w, ok := ch.World.(interface {
+		GainExp(*types.Character, int)
+	}); ok {
+		w.GainExp(ch, exp)
+	} else {
+		ch.Experience += exp
 	}
 }
 
@@ -397,6 +494,10 @@ func calculateExperience(attacker, defender *types.Character) int {
 
 // sendEnhancedCombatMessage sends combat messages to the attacker, defender, and room
 func sendEnhancedCombatMessage(attacker, defender *types.Character, damage int, weaponType int) {
+	if sendFileMessages(attacker, defender, damage, weaponType) {
+		return
+	}
+
 	// Get the attack messages
 	var attackerMsg, defenderMsg, roomMsg string
 
@@ -466,7 +567,7 @@ func getEnhancedWeaponType(ch *types.Character) int {
 	weapon := ch.Equipment[types.WEAR_WIELD]
 	if weapon != nil && weapon.Prototype != nil {
 		// Use the weapon's type
-		return weapon.Prototype.Value[3]
+		return types.WeaponType(weapon.Prototype.Value[3])
 	}
 	// Default to TYPE_HIT for bare hands
 	return types.TYPE_HIT
@@ -273,3 +273,57 @@ func TestProcessCombatClearsDeadTargets(t *testing.T) {
 		t.Errorf("Expected 0 combat states after ProcessCombat, got %d", len(manager.Combats))
 	}
 }
+
+// TestGroupGainSplitsByLevel tests that kill experience is shared by level among present group members
+func TestGroupGainSplitsByLevel(t *testing.T) {
+	room := &types.Room{VNUM: 3001}
+	elsewhere := &types.Room{VNUM: 3002}
+
+	leader := &types.Character{Name: "Leader", Level: 10, InRoom: room, AffectedBy: types.AFF_GROUP}
+	member := &types.Character{Name: "Member", Level: 5, InRoom: room, AffectedBy: types.AFF_GROUP, Following: leader}
+	absent := &types.Character{Name: "Absent", Level: 5, InRoom: elsewhere, AffectedBy: types.AFF_GROUP, Following: leader}
+	ungrouped := &types.Character{Name: "Ungrouped", Level: 5, InRoom: room, Following: leader}
+	leader.Followers = []*types.Character{member, absent, ungrouped}
+
+	groupGain(member, 1500)
+
+	if leader.Experience != 1000 {
+		t.Errorf("Expected leader to receive 1000 exp, got %d", leader.Experience)
+	}
+	if member.Experience != 500 {
+		t.Errorf("Expected member to receive 500 exp, got %d", member.Experience)
+	}
+	if absent.Experience != 0 || ungrouped.Experience != 0 {
+		t.Errorf("Expected absent and ungrouped followers to receive nothing, got %d and %d", absent.Experience, ungrouped.Experience)
+	}
+}
+
+// mockMessageWorld records the messages sent to characters
+type mockMessageWorld struct {
+	received map[*types.Character][]string
+}
+
+func (w *mockMessageWorld) SendMessageToCharacter(ch *types.Character, message string) {
+	w.received[ch] = append(w.received[ch], message)
+}
+
+// TestGroupGainReportsCappedExperience tests that members are told the experience they actually receive
+func TestGroupGainReportsCappedExperience(t *testing.T) {
+	world := &mockMessageWorld{received: make(map[*types.Character][]string)}
+	room := &types.Room{VNUM: 3001}
+
+	leader := &types.Character{Name: "Leader", Level: 20, InRoom: room, AffectedBy: types.AFF_GROUP, World: world}
+	member := &types.Character{Name: "Member", Level: 1, InRoom: room, AffectedBy: types.AFF_GROUP, Following: leader,
+		World: world}
+	leader.Followers = []*types.Character{member}
+
+	groupGain(leader, 210000)
+
+	if leader.Experience != types.MAX_EXP_GAIN || member.Experience != 10000 {
+		t.Errorf("Expected 100000 and 10000 exp, got %d and %d", leader.Experience, member.Experience)
+	}
+	want := "You receive your share of experience: 100000 points.\r\n"
+	if len(world.received[leader]) != 1 || world.received[leader][0] != want {
+		t.Errorf("Expected the leader to be told %q, got %q", want, world.received[leader])
+	}
+}
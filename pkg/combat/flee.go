@@ -0,0 +1,111 @@
+package combat
+
+import (
+	"math/rand"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// fleeWorld is the part of the world needed to flee
+type fleeWorld interface {
+	GetRandomExitRoom(*types.Room) (*types.Room, int)
+	CharacterMove(*types.Character, *types.Room)
+	Act(string, bool, *types.Character, *types.ObjectInstance, *types.Character, int)
+}
+
+// Flee tries to get a character out of the room through a random exit.
+// A character fleeing from a fight stops fighting and, for players, loses
+// experience for the damage done to the opponent. It returns true if the
+// character got away.
+func Flee(ch *types.Character, manager interface{ StopCombat(*types.Character) }) bool {
+	world, ok := ch.World.(fleeWorld)
+	if !ok || ch.InRoom == nil {
+		return false
+	}
+
+	opponent := ch.Fighting
+
+	// Fighting makes it harder to get away, more so when not on your feet
+	if opponent != nil && rand.Intn(100) >= fleeChance(ch) {
+		world.Act("$n tries to flee, but can't get away!", true, ch, nil, nil, types.TO_ROOM)
+		ch.SendMessage("PANIC! You couldn't escape!\r\n")
+		return false
+	}
+
+	destRoom := findFleeRoom(world, ch.InRoom)
+	if destRoom == nil {
+		ch.SendMessage("PANIC! You couldn't escape!\r\n")
+		return false
+	}
+
+	world.Act("$n panics, and attempts to flee.", true, ch, nil, nil, types.TO_ROOM)
+
+	if opponent != nil {
+		manager.StopCombat(ch)
+	}
+	ch.Position = types.POS_STANDING
+
+	world.CharacterMove(ch, destRoom)
+	ch.SendMessage("You flee head over heels.\r\n")
+
+	// Running away costs players experience
+	if opponent != nil && !ch.IsNPC {
+		loss := (opponent.MaxHitPoints - opponent.HP) * opponent.Level
+		if loss > 0 {
+			if w, ok := ch.World.(interface {
+				GainExp(*types.Character, int)
+			}); ok {
+				w.GainExp(ch, -loss)
+			}
+		}
+	}
+
+	return true
+}
+
+// WantsToFlee returns true if a fighting character is hurt badly enough to
+// flee on its own: wimpy mobiles below half their hit points and players
+// below their wimpy level
+func WantsToFlee(ch *types.Character) bool {
+	if ch.Fighting == nil || ch.HP <= 0 {
+		return false
+	}
+
+	if ch.IsNPC {
+		return ch.ActFlags&types.ACT_WIMPY != 0 && ch.HP < ch.MaxHitPoints/2
+	}
+
+	return ch.Wimpy > 0 && ch.HP < ch.Wimpy
+}
+
+// fleeChance returns the percent chance of getting away from a fight
+func fleeChance(ch *types.Character) int {
+	chance := 50 + 10*getEnhancedDexterityACBonus(ch.Abilities[types.ABILITY_DEX])
+
+	if ch.Position < types.POS_FIGHTING {
+		chance -= 25
+	}
+
+	return max(5, min(95, chance))
+}
+
+// findFleeRoom picks a random open exit that doesn't lead to a death trap
+func findFleeRoom(world fleeWorld, room *types.Room) *types.Room {
+	for attempt := 0; attempt < 6; attempt++ {
+		destRoom, dir := world.GetRandomExitRoom(room)
+		if destRoom == nil {
+			return nil
+		}
+
+		if exit := room.Exits[dir]; exit != nil && exit.IsClosed() {
+			continue
+		}
+		if destRoom.Flags&types.ROOM_DEATH != 0 {
+			continue
+		}
+
+		return destRoom
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package combat
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// mockFleeWorld provides the world methods used by Flee
+type mockFleeWorld struct {
+	dest   *types.Room
+	gained int
+}
+
+func (w *mockFleeWorld) GetRandomExitRoom(room *types.Room) (*types.Room, int) {
+	return w.dest, types.DIR_NORTH
+}
+
+func (w *mockFleeWorld) CharacterMove(ch *types.Character, room *types.Room) {
+	ch.InRoom = room
+}
+
+func (w *mockFleeWorld) Act(msg string, hide bool, ch *types.Character, obj *types.ObjectInstance, vict *types.Character, msgType int) {
+}
+
+func (w *mockFleeWorld) GainExp(ch *types.Character, gain int) {
+	w.gained += gain
+}
+
+// TestFleeFromCombat tests that fleeing stops combat, moves the character and costs experience
+func TestFleeFromCombat(t *testing.T) {
+	manager := NewEnhancedDikuCombatManager()
+
+	dest := &types.Room{VNUM: 3002}
+	room := &types.Room{VNUM: 3001}
+	room.Exits[types.DIR_NORTH] = &types.Exit{DestVnum: dest.VNUM}
+	world := &mockFleeWorld{dest: dest}
+
+	player := &types.Character{Name: "Player", InRoom: room, World: world, Abilities: [6]int{types.ABILITY_DEX: 18}}
+	mob := &types.Character{Name: "Mob", IsNPC: true, InRoom: room, Level: 3, HP: 10, MaxHitPoints: 30}
+
+	// A high dexterity gets away most of the time, so a few tries must succeed
+	fled := false
+	for i := 0; i < 50 && !fled; i++ {
+		manager.StartCombat(player, mob)
+		fled = Flee(player, manager)
+	}
+	if !fled {
+		t.Fatal("Expected player to flee")
+	}
+
+	if player.InRoom != dest {
+		t.Errorf("Expected player in room %d, got %v", dest.VNUM, player.InRoom)
+	}
+	if player.Fighting != nil || mob.Fighting != nil {
+		t.Error("Expected combat to be stopped")
+	}
+	if world.gained != -(30-10)*3 {
+		t.Errorf("Expected to lose %d exp, got %d", (30-10)*3, -world.gained)
+	}
+}
+
+// TestFleeDeathTrap tests that fleeing never leads into a death trap
+func TestFleeDeathTrap(t *testing.T) {
+	dest := &types.Room{VNUM: 3002, Flags: types.ROOM_DEATH}
+	room := &types.Room{VNUM: 3001}
+	room.Exits[types.DIR_NORTH] = &types.Exit{DestVnum: dest.VNUM}
+
+	player := &types.Character{Name: "Player", InRoom: room, World: &mockFleeWorld{dest: dest}}
+	if Flee(player, NewEnhancedDikuCombatManager()) {
+		t.Error("Expected flee into a death trap to fail")
+	}
+	if player.InRoom != room {
+		t.Error("Expected player to stay in the room")
+	}
+}
+
+// TestWantsToFlee tests the wimpy thresholds for players and wimpy mobiles
+func TestWantsToFlee(t *testing.T) {
+	opponent := &types.Character{Name: "Opponent"}
+
+	player := &types.Character{Name: "Player", HP: 15, MaxHitPoints: 100, Wimpy: 20, Fighting: opponent}
+	if !WantsToFlee(player) {
+		t.Error("Expected player below wimpy level to flee")
+	}
+	player.Wimpy = 0
+	if WantsToFlee(player) {
+		t.Error("Expected player without wimpy not to flee")
+	}
+
+	mob := &types.Character{Name: "Mob", IsNPC: true, HP: 40, MaxHitPoints: 100, Fighting: opponent, ActFlags: types.ACT_WIMPY}
+	if !WantsToFlee(mob) {
+		t.Error("Expected hurt wimpy mob to flee")
+	}
+	mob.ActFlags = 0
+	if WantsToFlee(mob) {
+		t.Error("Expected mob without ACT_WIMPY not to flee")
+	}
+}
@@ -28,6 +28,7 @@ func (c *BackstabCommand) Execute(character *types.Character, args string) error
 		CheckSkillSuccess(*types.Character, int) bool
 		UseSkill(*types.Character, int)
 		ImproveSkill(*types.Character, int, bool)
+		Damage(*types.Character, *types.Character, int, int)
 	})
 	if !ok {
 		return fmt.Errorf("world interface not available")
@@ -45,7 +46,7 @@ func (c *BackstabCommand) Execute(character *types.Character, args string) error
 	}
 
 	// Check if the weapon is a piercing weapon
-	if weapon.Prototype.Value[3] != types.TYPE_PIERCE {
+	if types.WeaponType(weapon.Prototype.Value[3]) != types.TYPE_PIERCE {
 		return fmt.Errorf("you need to wield a piercing weapon to backstab someone")
 	}
 
@@ -55,7 +56,7 @@ func (c *BackstabCommand) Execute(character *types.Character, args string) error
 	}
 
 	// Find the target in the room
-	victim := findCharacterInRoom(character.InRoom, args)
+	victim := findCharacterInRoom(character, args)
 	if victim == nil {
 		return fmt.Errorf("they aren't here")
 	}
@@ -85,17 +86,8 @@ func (c *BackstabCommand) Execute(character *types.Character, args string) error
 	world.ImproveSkill(character, types.SKILL_BACKSTAB, success)
 
 	if !success {
-		// Backstab failed
-		// Send messages
-		character.SendMessage(fmt.Sprintf("You try to backstab %s, but they see you coming!\r\n", victim.ShortDesc))
-		victim.SendMessage(fmt.Sprintf("%s tries to backstab you, but you see them coming!\r\n", character.Name))
-
-		// Send a message to the room
-		for _, ch := range character.InRoom.Characters {
-			if ch != character && ch != victim {
-				ch.SendMessage(fmt.Sprintf("%s tries to backstab %s, but is seen!\r\n", character.Name, victim.ShortDesc))
-			}
-		}
+		// Backstab failed, damage shows everyone the miss
+		world.Damage(character, victim, 0, types.SkillAttackType(types.SKILL_BACKSTAB))
 
 		// Start combat normally
 		c.CombatManager.StartCombat(character, victim)
@@ -155,21 +147,7 @@ func (c *BackstabCommand) Execute(character *types.Character, args string) error
 	}
 
 	// Apply damage
-	victim.HP -= damage
-	if victim.HP < 0 {
-		victim.HP = 0
-	}
-
-	// Send messages
-	character.SendMessage(fmt.Sprintf("You backstab %s for %d damage!\r\n", victim.ShortDesc, damage))
-	victim.SendMessage(fmt.Sprintf("%s backstabs you for %d damage!\r\n", character.Name, damage))
-
-	// Send a message to the room
-	for _, ch := range character.InRoom.Characters {
-		if ch != character && ch != victim {
-			ch.SendMessage(fmt.Sprintf("%s backstabs %s with deadly precision!\r\n", character.Name, victim.ShortDesc))
-		}
-	}
+	world.Damage(character, victim, damage, types.SkillAttackType(types.SKILL_BACKSTAB))
 
 	// Start combat
 	c.CombatManager.StartCombat(character, victim)
@@ -178,17 +156,6 @@ func (c *BackstabCommand) Execute(character *types.Character, args string) error
 	if victim.HP <= 0 {
 		victim.Position = types.POS_DEAD
 
-		// Send death messages
-		character.SendMessage(fmt.Sprintf("You have slain %s!\r\n", victim.ShortDesc))
-		victim.SendMessage(fmt.Sprintf("%s has slain you!\r\n", character.Name))
-
-		// Send a message to the room
-		for _, ch := range character.InRoom.Characters {
-			if ch != character && ch != victim {
-				ch.SendMessage(fmt.Sprintf("%s has slain %s!\r\n", character.Name, victim.ShortDesc))
-			}
-		}
-
 		// Handle character death using the centralized death handler
 		w, ok := victim.World.(interface {
 			HandleCharacterDeath(*types.Character)
@@ -26,6 +26,7 @@ func (c *BashCommand) Execute(character *types.Character, args string) error {
 		CheckSkillSuccess(*types.Character, int) bool
 		UseSkill(*types.Character, int)
 		ImproveSkill(*types.Character, int, bool)
+		Damage(*types.Character, *types.Character, int, int)
 	})
 	if !ok {
 		return fmt.Errorf("world interface not available")
@@ -51,7 +52,7 @@ func (c *BashCommand) Execute(character *types.Character, args string) error {
 		victim = character.Fighting
 	} else {
 		// Find the target in the room
-		victim = findCharacterInRoom(character.InRoom, args)
+		victim = findCharacterInRoom(character, args)
 		if victim == nil {
 			return fmt.Errorf("they aren't here")
 		}
@@ -72,17 +73,8 @@ func (c *BashCommand) Execute(character *types.Character, args string) error {
 	world.ImproveSkill(character, types.SKILL_BASH, success)
 
 	if !success {
-		// Bash failed
-		// Send messages
-		character.SendMessage(fmt.Sprintf("You try to bash %s, but fall flat on your face!\r\n", victim.ShortDesc))
-		victim.SendMessage(fmt.Sprintf("%s tries to bash you, but falls flat on their face!\r\n", character.Name))
-
-		// Send a message to the room
-		for _, ch := range character.InRoom.Characters {
-			if ch != character && ch != victim {
-				ch.SendMessage(fmt.Sprintf("%s tries to bash %s, but falls flat on their face!\r\n", character.Name, victim.ShortDesc))
-			}
-		}
+		// Bash failed, damage shows everyone the miss
+		world.Damage(character, victim, 0, types.SkillAttackType(types.SKILL_BASH))
 
 		// Character falls down
 		character.Position = types.POS_SITTING
@@ -93,25 +85,8 @@ func (c *BashCommand) Execute(character *types.Character, args string) error {
 		return nil
 	}
 
-	// Bash succeeded
-	// Send messages
-	character.SendMessage(fmt.Sprintf("You bash %s, sending them sprawling!\r\n", victim.ShortDesc))
-	victim.SendMessage(fmt.Sprintf("%s bashes you, sending you sprawling!\r\n", character.Name))
-
-	// Send a message to the room
-	for _, ch := range character.InRoom.Characters {
-		if ch != character && ch != victim {
-			ch.SendMessage(fmt.Sprintf("%s bashes %s, sending them sprawling!\r\n", character.Name, victim.ShortDesc))
-		}
-	}
-
-	// Deal minor damage (1 point)
-	if victim.HP > 0 {
-		victim.HP -= 1
-		if victim.HP < 0 {
-			victim.HP = 0
-		}
-	}
+	// Bash succeeded, dealing minor damage (1 point)
+	world.Damage(character, victim, 1, types.SkillAttackType(types.SKILL_BASH))
 
 	// Victim falls down
 	victim.Position = types.POS_SITTING
@@ -0,0 +1,201 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// maxHeadlineLength is the longest headline a board message can have
+const maxHeadlineLength = 80
+
+// boardWorld is implemented by worlds with bulletin boards
+type boardWorld interface {
+	Act(string, bool, *types.Character, *types.ObjectInstance, *types.Character, int)
+	IsBoard(*types.ObjectInstance) bool
+	BoardMessages(*types.ObjectInstance) []*types.BoardMessage
+	PostBoardMessage(board *types.ObjectInstance, author, title, text string) error
+	RemoveBoardMessage(board *types.ObjectInstance, n int) error
+}
+
+// WriteCommand writes a message on a bulletin board
+type WriteCommand struct{}
+
+// Execute executes the write command
+func (c *WriteCommand) Execute(character *types.Character, args string) error {
+	world, ok := character.World.(boardWorld)
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	board := findBoard(world, character)
+	if board == nil {
+		return fmt.Errorf("There is no board here to write on.")
+	}
+
+	title := strings.TrimSpace(args)
+	if title == "" {
+		return fmt.Errorf("We must have a headline!")
+	}
+	if len(title) > maxHeadlineLength {
+		title = title[:maxHeadlineLength]
+	}
+
+	if len(world.BoardMessages(board)) >= types.MAX_BOARD_MESSAGES {
+		return fmt.Errorf("The board is full already.")
+	}
+
+	character.SendMessage("Write your message. Terminate with a @.\r\n\r\n")
+	world.Act("$n starts to write a message.", true, character, nil, nil, types.TO_ROOM)
+
+	character.StartEditor(types.MAX_MESSAGE_LENGTH, func(text string) {
+		if err := world.PostBoardMessage(board, character.Name, title, text); err != nil {
+			character.SendMessage(err.Error() + "\r\n")
+			return
+		}
+		character.SendMessage("Ok.\r\n")
+	})
+
+	return nil
+}
+
+// Name returns the name of the command
+func (c *WriteCommand) Name() string {
+	return "write"
+}
+
+// Aliases returns the aliases of the command
+func (c *WriteCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *WriteCommand) MinPosition() int {
+	return types.POS_RESTING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *WriteCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *WriteCommand) LogCommand() bool {
+	return false
+}
+
+// ReadCommand reads a message on a bulletin board, or looks at something
+type ReadCommand struct{}
+
+// Execute executes the read command
+func (c *ReadCommand) Execute(character *types.Character, args string) error {
+	args = strings.TrimSpace(args)
+	if args == "" {
+		return fmt.Errorf("Read what?")
+	}
+
+	if n, err := strconv.Atoi(args); err == nil {
+		if world, ok := character.World.(boardWorld); ok {
+			if board := findBoard(world, character); board != nil {
+				return readBoardMessage(world, character, board, n)
+			}
+		}
+	}
+
+	// Reading anything else is looking at it
+	return (&LookCommand{}).Execute(character, args)
+}
+
+// Name returns the name of the command
+func (c *ReadCommand) Name() string {
+	return "read"
+}
+
+// Aliases returns the aliases of the command
+func (c *ReadCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *ReadCommand) MinPosition() int {
+	return types.POS_RESTING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *ReadCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *ReadCommand) LogCommand() bool {
+	return false
+}
+
+// findBoard returns the bulletin board in the character's room, if any
+func findBoard(world boardWorld, character *types.Character) *types.ObjectInstance {
+	if character.InRoom == nil {
+		return nil
+	}
+
+	character.InRoom.RLock()
+	defer character.InRoom.RUnlock()
+
+	for _, obj := range character.InRoom.Objects {
+		if world.IsBoard(obj) {
+			return obj
+		}
+	}
+	return nil
+}
+
+// showBoard lists the messages on a board
+func showBoard(world boardWorld, board *types.ObjectInstance) string {
+	var sb strings.Builder
+	sb.WriteString("This is a bulletin board. Usage: READ/REMOVE <messg #>, WRITE <header>\r\n")
+
+	posts := world.BoardMessages(board)
+	if len(posts) == 0 {
+		sb.WriteString("The board is empty.\r\n")
+		return sb.String()
+	}
+
+	sb.WriteString(fmt.Sprintf("There are %d messages on the board.\r\n", len(posts)))
+	for i, post := range posts {
+		sb.WriteString(fmt.Sprintf("%-2d : %s (%s)\r\n", i+1, post.Title, post.Author))
+	}
+	return sb.String()
+}
+
+// readBoardMessage shows message number n on a board
+func readBoardMessage(world boardWorld, character *types.Character, board *types.ObjectInstance, n int) error {
+	posts := world.BoardMessages(board)
+	if n < 1 || n > len(posts) {
+		return fmt.Errorf("That message exists only in your imagination..")
+	}
+
+	post := posts[n-1]
+	character.PageString(fmt.Sprintf("Message %d : %s (%s)\r\n\r\n%s", n, post.Title, post.Author, post.Text))
+	return nil
+}
+
+// removeBoardMessage removes message number n from a board. Only its author
+// and immortals may remove a message.
+func removeBoardMessage(world boardWorld, character *types.Character, board *types.ObjectInstance, n int) error {
+	posts := world.BoardMessages(board)
+	if n < 1 || n > len(posts) {
+		return fmt.Errorf("That message exists only in your imagination..")
+	}
+
+	if !strings.EqualFold(posts[n-1].Author, character.Name) && character.Level < types.LEVEL_IMMORTAL {
+		return fmt.Errorf("You can only remove your own messages.")
+	}
+
+	if err := world.RemoveBoardMessage(board, n); err != nil {
+		return err
+	}
+
+	world.Act(fmt.Sprintf("$n just removed message %d.", n), false, character, nil, nil, types.TO_ROOM)
+	return fmt.Errorf("Message removed.")
+}
@@ -0,0 +1,100 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// MockWorldForBoard keeps board messages in memory
+type MockWorldForBoard struct {
+	MockWorldForSocial
+	posts    []*types.BoardMessage
+	messages []string
+}
+
+func (m *MockWorldForBoard) IsBoard(obj *types.ObjectInstance) bool {
+	return obj.Prototype.VNUM == 3099
+}
+
+func (m *MockWorldForBoard) BoardMessages(board *types.ObjectInstance) []*types.BoardMessage {
+	return append([]*types.BoardMessage(nil), m.posts...)
+}
+
+func (m *MockWorldForBoard) PostBoardMessage(board *types.ObjectInstance, author, title, text string) error {
+	m.posts = append(m.posts, &types.BoardMessage{Author: author, Title: title, Text: text})
+	return nil
+}
+
+func (m *MockWorldForBoard) RemoveBoardMessage(board *types.ObjectInstance, n int) error {
+	m.posts = append(m.posts[:n-1], m.posts[n:]...)
+	return nil
+}
+
+func (m *MockWorldForBoard) SendMessageToCharacter(ch *types.Character, message string) {
+	m.messages = append(m.messages, message)
+}
+
+func TestBoardWriteReadRemove(t *testing.T) {
+	world := &MockWorldForBoard{}
+	room := &types.Room{VNUM: 3001}
+	room.Objects = []*types.ObjectInstance{{Prototype: &types.Object{VNUM: 3099, Name: "board bulletin", ShortDesc: "a bulletin board"}}}
+	alice := &types.Character{Name: "Alice", Position: types.POS_STANDING, InRoom: room, World: world}
+	bob := &types.Character{Name: "Bob", Position: types.POS_STANDING, InRoom: room, World: world}
+	room.Characters = []*types.Character{alice, bob}
+
+	if err := (&LookCommand{}).Execute(alice, "at board"); err == nil || !strings.Contains(err.Error(), "The board is empty.") {
+		t.Errorf("Expected an empty board, got %v", err)
+	}
+
+	if err := (&WriteCommand{}).Execute(alice, ""); err == nil || err.Error() != "We must have a headline!" {
+		t.Errorf("Expected a headline to be required, got %v", err)
+	}
+
+	// The message is written line by line until '@'
+	if err := (&WriteCommand{}).Execute(alice, "Hello world"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !alice.IsEditing() {
+		t.Fatal("Expected Alice to be writing")
+	}
+	alice.EditLine("First line")
+	alice.EditLine("Second line@ignored")
+	if alice.IsEditing() || len(world.posts) != 1 {
+		t.Fatalf("Expected the message to be posted, got %d posts", len(world.posts))
+	}
+	if world.posts[0].Text != "First line\r\nSecond line\r\n" || world.posts[0].Author != "Alice" {
+		t.Errorf("Unexpected post: %+v", world.posts[0])
+	}
+
+	if err := (&LookCommand{}).Execute(bob, "board"); err == nil || !strings.Contains(err.Error(), "1  : Hello world (Alice)") {
+		t.Errorf("Expected the message in the listing, got %v", err)
+	}
+
+	world.messages = nil
+	if err := (&ReadCommand{}).Execute(bob, "1"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(strings.Join(world.messages, ""), "Message 1 : Hello world (Alice)\r\n\r\nFirst line") {
+		t.Errorf("Unexpected message: %q", world.messages)
+	}
+	if err := (&ReadCommand{}).Execute(bob, "2"); err == nil || !strings.Contains(err.Error(), "imagination") {
+		t.Errorf("Expected no message 2, got %v", err)
+	}
+
+	// Only the author or an immortal may remove a message
+	if err := (&RemoveCommand{}).Execute(bob, "1"); err == nil || err.Error() != "You can only remove your own messages." {
+		t.Errorf("Expected Bob to be refused, got %v", err)
+	}
+	bob.Level = types.LEVEL_IMMORTAL
+	if err := (&RemoveCommand{}).Execute(bob, "1"); err == nil || err.Error() != "Message removed." {
+		t.Errorf("Expected an immortal to remove the message, got %v", err)
+	}
+	if len(world.posts) != 0 {
+		t.Errorf("Expected no posts left, got %d", len(world.posts))
+	}
+	if world.calls[len(world.calls)-1].msg != "$n just removed message 1." {
+		t.Errorf("Unexpected room message: %v", world.calls)
+	}
+}
@@ -78,7 +78,7 @@ func DoCast(character *types.Character, arguments string, world *world.World) er
 	}
 
 	// Check if character has enough mana
-	manaCost := types.GetSpellMana(spellID)
+	manaCost := types.GetSpellMana(spellID, character.Level, character.Class)
 	if character.ManaPoints < manaCost {
 		return fmt.Errorf("you can't summon enough energy to cast the spell")
 	}
@@ -129,7 +129,7 @@ func DoCast(character *types.Character, arguments string, world *world.World) er
 	}
 
 	// Cast the spell
-	err = castSpell(world, spellID, character.Level, character, targetArg, types.SPELL_TYPE_SPELL, victim, obj)
+	err = world.CastSpell(spellID, character.Level, character, targetArg, types.SPELL_TYPE_SPELL, victim, obj)
 	if err != nil {
 		return err
 	}
@@ -145,95 +145,3 @@ func DoCast(character *types.Character, arguments string, world *world.World) er
 
 	return nil
 }
-
-// castSpell dispatches to the appropriate spell function
-func castSpell(w *world.World, spellID, level int, ch *types.Character, arg string, spellType int, victim *types.Character, obj *types.ObjectInstance) error {
-	switch spellID {
-	case types.SPELL_ARMOR:
-		return w.CastArmor(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_TELEPORT:
-		return w.CastWordOfRecall(level, ch, arg, spellType, victim, obj) // Using word of recall for now
-	case types.SPELL_BLESS:
-		return w.CastBless(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_BLINDNESS:
-		return w.CastBlindness(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_BURNING_HANDS:
-		return w.CastBurningHands(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_CALL_LIGHTNING:
-		return w.CastCallLightning(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_CHARM_PERSON:
-		return w.CastCharmPerson(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_CHILL_TOUCH:
-		return w.CastChillTouch(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_COLOR_SPRAY:
-		return w.CastColorSpray(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_CONTROL_WEATHER:
-		return w.CastControlWeather(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_CREATE_FOOD:
-		return w.CastCreateFood(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_CREATE_WATER:
-		return w.CastCreateWater(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_CURE_BLIND:
-		return w.CastCureBlindness(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_CURE_CRITIC:
-		return w.CastCureCritic(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_CURE_LIGHT:
-		return w.CastCureLight(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_CURSE:
-		return w.CastCurse(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_DETECT_EVIL:
-		return w.CastDetectEvil(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_DETECT_INVISIBLE:
-		return w.CastDetectInvisible(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_DETECT_MAGIC:
-		return w.CastDetectMagic(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_DETECT_POISON:
-		return w.CastDetectPoison(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_DISPEL_EVIL:
-		return w.CastDispelEvil(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_EARTHQUAKE:
-		return w.CastEarthquake(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_ENCHANT_WEAPON:
-		return w.CastEnchantWeapon(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_ENERGY_DRAIN:
-		return w.CastEnergyDrain(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_FIREBALL:
-		return w.CastFireball(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_HARM:
-		return w.CastHarm(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_HEAL:
-		return w.CastHeal(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_INVISIBLE:
-		return w.CastInvisible(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_LIGHTNING_BOLT:
-		return w.CastLightningBolt(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_LOCATE_OBJECT:
-		return w.CastLocateObject(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_MAGIC_MISSILE:
-		return w.CastMagicMissile(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_POISON:
-		return w.CastPoison(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_PROTECTION_FROM_EVIL:
-		return w.CastProtectionFromEvil(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_REMOVE_CURSE:
-		return w.CastRemoveCurse(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_SANCTUARY:
-		return w.CastSanctuary(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_SHOCKING_GRASP:
-		return w.CastShockingGrasp(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_SLEEP:
-		return w.CastSleep(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_STRENGTH:
-		return w.CastStrength(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_SUMMON:
-		return w.CastSummon(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_WORD_OF_RECALL:
-		return w.CastWordOfRecall(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_REMOVE_POISON:
-		return w.CastRemovePoison(level, ch, arg, spellType, victim, obj)
-	case types.SPELL_SENSE_LIFE:
-		return w.CastSenseLife(level, ch, arg, spellType, victim, obj)
-	default:
-		return fmt.Errorf("sorry, this magic has not yet been implemented")
-	}
-}
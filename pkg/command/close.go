@@ -224,17 +224,17 @@ func (c *CloseCommand) closeContainer(character *types.Character, container *typ
 	}
 
 	// Check if it's already closed
-	if (container.Prototype.Value[1] & types.CONT_CLOSED) != 0 {
+	if (container.ContainerFlags() & types.CONT_CLOSED) != 0 {
 		return fmt.Errorf("but it's already closed!")
 	}
 
 	// Check if it can be closed
-	if (container.Prototype.Value[1] & types.CONT_CLOSEABLE) == 0 {
+	if (container.ContainerFlags() & types.CONT_CLOSEABLE) == 0 {
 		return fmt.Errorf("that's impossible.")
 	}
 
 	// Close the container by setting the CLOSED flag
-	container.Value[1] = container.Prototype.Value[1] | types.CONT_CLOSED
+	container.SetContainerFlags(container.ContainerFlags() | types.CONT_CLOSED)
 
 	// Send messages
 	character.SendMessage("Ok.\r\n")
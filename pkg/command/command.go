@@ -2,6 +2,7 @@ package command
 
 import (
 	"errors"
+	"sync"
 
 	"github.com/wltechblog/DikuGo/pkg/types"
 )
@@ -30,6 +31,7 @@ type Command interface {
 // Registry is a registry of commands
 type Registry struct {
 	commands map[string]Command
+	mutex    sync.RWMutex
 }
 
 // NewRegistry creates a new command registry
@@ -41,6 +43,9 @@ func NewRegistry() *Registry {
 
 // Register registers a command with the registry
 func (r *Registry) Register(cmd Command) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
 	// Register the command by its name
 	r.commands[cmd.Name()] = cmd
 
@@ -52,9 +57,35 @@ func (r *Registry) Register(cmd Command) {
 
 // Find finds a command by name or alias
 func (r *Registry) Find(name string) Command {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
 	return r.commands[name]
 }
 
+// Commands returns a copy of the registered commands keyed by name and alias
+func (r *Registry) Commands() map[string]Command {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	commands := make(map[string]Command, len(r.commands))
+	for name, cmd := range r.commands {
+		commands[name] = cmd
+	}
+	return commands
+}
+
+// Unregister removes a command and its aliases from the registry
+func (r *Registry) Unregister(cmd Command) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for name, registered := range r.commands {
+		if registered == cmd {
+			delete(r.commands, name)
+		}
+	}
+}
+
 // Execute executes a command
 func (r *Registry) Execute(character *types.Character, input string) error {
 	// Parse the command and arguments
@@ -97,10 +128,28 @@ func (r *Registry) Execute(character *types.Character, input string) error {
 		return ErrInsufficientLevel
 	}
 
+	// Doing anything but looking around brings a hiding character out
+	if !stealthyCommands[cmd.Name()] {
+		character.AffectedBy &^= types.AFF_HIDE
+	}
+
 	// Execute the command
 	return cmd.Execute(character, args)
 }
 
+// stealthyCommands can be used without giving away a hiding place
+var stealthyCommands = map[string]bool{
+	"hide":      true,
+	"sneak":     true,
+	"look":      true,
+	"who":       true,
+	"score":     true,
+	"inventory": true,
+	"equipment": true,
+	"time":      true,
+	"help":      true,
+}
+
 // parseCommand parses a command string into a command name and arguments
 func parseCommand(input string) (string, string) {
 	// Find the first space
@@ -207,6 +207,7 @@ func TestGetFromClosedContainer(t *testing.T) {
 			Type:      types.ITEM_CONTAINER,
 			Value:     [4]int{100, types.CONT_CLOSED, 0, 0}, // Closed container
 		},
+		Value:    [4]int{100, types.CONT_CLOSED, 0, 0},
 		Contains: make([]*types.ObjectInstance, 0),
 	}
 	room.Objects = append(room.Objects, chest)
@@ -45,6 +45,7 @@ func (c *DropCommand) Execute(character *types.Character, args string) error {
 	obj.CarriedBy = nil
 	obj.InRoom = character.InRoom
 	character.InRoom.Objects = append(character.InRoom.Objects, obj)
+	updateRoomLight(character)
 
 	// Send a message to the character
 	return fmt.Errorf("you drop %s.\r\n", obj.Prototype.ShortDesc)
@@ -82,6 +83,8 @@ func (c *DropCommand) dropAll(character *types.Character) error {
 		sb.WriteString(fmt.Sprintf("You drop %s.\r\n", obj.Prototype.ShortDesc))
 	}
 
+	updateRoomLight(character)
+
 	// Send the messages to the character
 	return fmt.Errorf("%s", sb.String())
 }
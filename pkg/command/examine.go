@@ -54,7 +54,7 @@ func (c *ExamineCommand) Execute(character *types.Character, args string) error
 	obj = findObjectInInventory(character, args)
 	if obj == nil {
 		// Then check room
-		obj = findObjectInRoom(character.InRoom, args)
+		obj = findObjectInRoom(character, args)
 	}
 	if obj == nil {
 		// Then check equipment
@@ -73,7 +73,7 @@ func (c *ExamineCommand) Execute(character *types.Character, args string) error
 	// If it's a container, show its contents
 	if obj.Prototype.Type == types.ITEM_CONTAINER {
 		// Check if the container is closed
-		if obj.Prototype.Value[1]&types.CONT_CLOSED != 0 {
+		if obj.ContainerFlags()&types.CONT_CLOSED != 0 {
 			return fmt.Errorf("%s is closed.", obj.Prototype.ShortDesc)
 		}
 
@@ -0,0 +1,113 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/combat"
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// FleeCommand represents the flee command
+type FleeCommand struct {
+	// CombatManager is the combat manager
+	CombatManager CombatManagerInterface
+}
+
+// Execute executes the flee command
+func (c *FleeCommand) Execute(character *types.Character, args string) error {
+	if character.InRoom == nil {
+		return fmt.Errorf("you are not in a room")
+	}
+
+	// Flee reports its own success or failure
+	combat.Flee(character, c.CombatManager)
+	return nil
+}
+
+// Name returns the name of the command
+func (c *FleeCommand) Name() string {
+	return "flee"
+}
+
+// Aliases returns the aliases of the command
+func (c *FleeCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *FleeCommand) MinPosition() int {
+	return types.POS_RESTING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *FleeCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *FleeCommand) LogCommand() bool {
+	return false
+}
+
+// WimpyCommand sets the hit points below which a player flees automatically
+type WimpyCommand struct{}
+
+// Execute executes the wimpy command
+func (c *WimpyCommand) Execute(character *types.Character, args string) error {
+	if character.IsNPC {
+		return fmt.Errorf("You ain't nothin' but a hound-dog.")
+	}
+
+	args = strings.TrimSpace(args)
+	if args == "" {
+		if character.Wimpy > 0 {
+			return fmt.Errorf("Your current wimp level is %d hit points.", character.Wimpy)
+		}
+		return fmt.Errorf("At the moment, you're not a wimp.  (sure, sure...)")
+	}
+
+	wimpy, err := strconv.Atoi(args)
+	if err != nil {
+		return fmt.Errorf("Specify at how many hit points you want to wimp out at.  (0 to disable)")
+	}
+
+	switch {
+	case wimpy < 0:
+		return fmt.Errorf("Heh, heh, heh.. we are jolly funny today, eh?")
+	case wimpy > character.MaxHitPoints/2:
+		return fmt.Errorf("You can't set your wimp level above half your hit points.")
+	case wimpy == 0:
+		character.Wimpy = 0
+		return fmt.Errorf("Okay, you will now tough out fights to the bitter end.")
+	default:
+		character.Wimpy = wimpy
+		return fmt.Errorf("Okay, you'll wimp out if you drop below %d hit points.", wimpy)
+	}
+}
+
+// Name returns the name of the command
+func (c *WimpyCommand) Name() string {
+	return "wimpy"
+}
+
+// Aliases returns the aliases of the command
+func (c *WimpyCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *WimpyCommand) MinPosition() int {
+	return types.POS_DEAD
+}
+
+// Level returns the minimum level required to execute the command
+func (c *WimpyCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *WimpyCommand) LogCommand() bool {
+	return false
+}
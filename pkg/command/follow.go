@@ -0,0 +1,148 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// followWorld is the part of the world the follow and group commands need
+type followWorld interface {
+	AddFollower(*types.Character, *types.Character)
+	StopFollower(*types.Character)
+	CircleFollow(*types.Character, *types.Character) bool
+	Act(string, bool, *types.Character, *types.ObjectInstance, *types.Character, int)
+}
+
+// FollowCommand represents the follow command
+type FollowCommand struct{}
+
+// Execute executes the follow command
+func (c *FollowCommand) Execute(character *types.Character, args string) error {
+	world, ok := character.World.(followWorld)
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	name := strings.TrimSpace(args)
+	if name == "" {
+		return fmt.Errorf("Who do you wish to follow?")
+	}
+
+	if character.InRoom == nil {
+		return fmt.Errorf("you are not in a room")
+	}
+
+	var leader *types.Character
+	if strings.EqualFold(name, "self") || strings.EqualFold(name, "me") {
+		leader = character
+	} else {
+		character.InRoom.RLock()
+		leader = findCharacterInRoom(character, name)
+		character.InRoom.RUnlock()
+	}
+	if leader == nil {
+		return fmt.Errorf("I see no person by that name here!")
+	}
+
+	// Charmed characters only follow their master
+	if character.AffectedBy&types.AFF_CHARM != 0 && character.Following != nil {
+		world.Act("But you only feel like following $N!", false, character, nil, character.Following, types.TO_CHAR)
+		return nil
+	}
+
+	// Following yourself means following no one
+	if leader == character {
+		if character.Following == nil {
+			return fmt.Errorf("You are already following yourself.")
+		}
+		world.StopFollower(character)
+		return nil
+	}
+
+	if character.Following == leader {
+		world.Act("You are already following $N.", false, character, nil, leader, types.TO_CHAR)
+		return nil
+	}
+
+	if world.CircleFollow(character, leader) {
+		return fmt.Errorf("Sorry, but following in 'loops' is not allowed.")
+	}
+
+	world.AddFollower(character, leader)
+	return nil
+}
+
+// Name returns the name of the command
+func (c *FollowCommand) Name() string {
+	return "follow"
+}
+
+// Aliases returns the aliases of the command
+func (c *FollowCommand) Aliases() []string {
+	return []string{"fol"}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *FollowCommand) MinPosition() int {
+	return types.POS_RESTING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *FollowCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *FollowCommand) LogCommand() bool {
+	return false
+}
+
+// UnfollowCommand represents the unfollow command
+type UnfollowCommand struct{}
+
+// Execute executes the unfollow command
+func (c *UnfollowCommand) Execute(character *types.Character, args string) error {
+	world, ok := character.World.(followWorld)
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	if character.Following == nil {
+		return fmt.Errorf("You are not following anyone.")
+	}
+
+	if character.AffectedBy&types.AFF_CHARM != 0 {
+		world.Act("But you only feel like following $N!", false, character, nil, character.Following, types.TO_CHAR)
+		return nil
+	}
+
+	world.StopFollower(character)
+	return nil
+}
+
+// Name returns the name of the command
+func (c *UnfollowCommand) Name() string {
+	return "unfollow"
+}
+
+// Aliases returns the aliases of the command
+func (c *UnfollowCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *UnfollowCommand) MinPosition() int {
+	return types.POS_RESTING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *UnfollowCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *UnfollowCommand) LogCommand() bool {
+	return false
+}
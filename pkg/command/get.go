@@ -43,7 +43,7 @@ func (c *GetCommand) Execute(character *types.Character, args string) error {
 
 	if container != "" {
 		// Get from container
-		containerObj = findObjectInRoom(character.InRoom, container)
+		containerObj = findObjectInRoom(character, container)
 		if containerObj == nil {
 			containerObj = findObjectInInventory(character, container)
 		}
@@ -57,7 +57,7 @@ func (c *GetCommand) Execute(character *types.Character, args string) error {
 		}
 
 		// Check if the container is closed
-		if containerObj.Prototype.Value[1]&types.CONT_CLOSED != 0 {
+		if containerObj.ContainerFlags()&types.CONT_CLOSED != 0 {
 			return fmt.Errorf("%s is closed", containerObj.Prototype.ShortDesc)
 		}
 
@@ -67,7 +67,7 @@ func (c *GetCommand) Execute(character *types.Character, args string) error {
 		}
 	} else {
 		// Get from room
-		obj = findObjectInRoom(character.InRoom, target)
+		obj = findObjectInRoom(character, target)
 		if obj == nil {
 			return fmt.Errorf("you don't see %s here", target)
 		}
@@ -84,7 +84,7 @@ func (c *GetCommand) Execute(character *types.Character, args string) error {
 	// Remove the object from its current location
 	if container != "" {
 		// Remove from container
-		containerObj := findObjectInRoom(character.InRoom, container)
+		containerObj := findObjectInRoom(character, container)
 		if containerObj == nil {
 			containerObj = findObjectInInventory(character, container)
 		}
@@ -92,6 +92,7 @@ func (c *GetCommand) Execute(character *types.Character, args string) error {
 	} else {
 		// Remove from room
 		removeObjectFromRoom(character.InRoom, obj)
+		updateRoomLight(character)
 	}
 
 	// Handle money objects specially
@@ -139,7 +140,7 @@ func (c *GetCommand) getAll(character *types.Character, container string) error
 
 	if container != "" {
 		// Get from container
-		containerObj = findObjectInRoom(character.InRoom, container)
+		containerObj = findObjectInRoom(character, container)
 		if containerObj == nil {
 			containerObj = findObjectInInventory(character, container)
 		}
@@ -153,7 +154,7 @@ func (c *GetCommand) getAll(character *types.Character, container string) error
 		}
 
 		// Check if the container is closed
-		if containerObj.Prototype.Value[1]&types.CONT_CLOSED != 0 {
+		if containerObj.ContainerFlags()&types.CONT_CLOSED != 0 {
 			return fmt.Errorf("%s is closed", containerObj.Prototype.ShortDesc)
 		}
 
@@ -166,7 +167,7 @@ func (c *GetCommand) getAll(character *types.Character, container string) error
 	// Check if there are any objects
 	if len(objects) == 0 {
 		if container != "" {
-			containerObj := findObjectInRoom(character.InRoom, container)
+			containerObj := findObjectInRoom(character, container)
 			if containerObj == nil {
 				containerObj = findObjectInInventory(character, container)
 			}
@@ -196,7 +197,7 @@ func (c *GetCommand) getAll(character *types.Character, container string) error
 		// Remove the object from its current location
 		if container != "" {
 			// Remove from container
-			containerObj := findObjectInRoom(character.InRoom, container)
+			containerObj := findObjectInRoom(character, container)
 			if containerObj == nil {
 				containerObj = findObjectInInventory(character, container)
 			}
@@ -204,6 +205,7 @@ func (c *GetCommand) getAll(character *types.Character, container string) error
 		} else {
 			// Remove from room
 			removeObjectFromRoom(character.InRoom, obj)
+			updateRoomLight(character)
 		}
 
 		// Handle money objects specially
@@ -269,13 +271,18 @@ func (c *GetCommand) LogCommand() bool {
 	return false
 }
 
-// findObjectInRoom finds an object in a room by name
-func findObjectInRoom(room *types.Room, name string) *types.ObjectInstance {
+// findObjectInRoom finds an object the viewer can see in the viewer's room
+// by name
+func findObjectInRoom(viewer *types.Character, name string) *types.ObjectInstance {
 	// Convert the name to lowercase
 	name = strings.ToLower(name)
 
 	// Check each object in the room
-	for _, obj := range room.Objects {
+	for _, obj := range viewer.InRoom.Objects {
+		if !canSeeObj(viewer, obj) {
+			continue
+		}
+
 		// Check if the object's name contains the search name
 		if strings.Contains(strings.ToLower(obj.Prototype.Name), name) {
 			return obj
@@ -290,13 +297,18 @@ func findObjectInRoom(room *types.Room, name string) *types.ObjectInstance {
 	return nil
 }
 
-// findObjectInInventory finds an object in a character's inventory by name
+// findObjectInInventory finds an object the character can see in their
+// inventory by name
 func findObjectInInventory(character *types.Character, name string) *types.ObjectInstance {
 	// Convert the name to lowercase
 	name = strings.ToLower(name)
 
 	// Check each object in the inventory
 	for _, obj := range character.Inventory {
+		if !canSeeObj(character, obj) {
+			continue
+		}
+
 		// Check if the object's name contains the search name
 		if strings.Contains(strings.ToLower(obj.Prototype.Name), name) {
 			return obj
@@ -319,7 +331,7 @@ func findObjectInContainer(container *types.ObjectInstance, name string) *types.
 	}
 
 	// Check if the container is closed
-	if container.Prototype.Value[1]&types.CONT_CLOSED != 0 {
+	if container.ContainerFlags()&types.CONT_CLOSED != 0 {
 		return nil
 	}
 
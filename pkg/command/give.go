@@ -0,0 +1,143 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/ai"
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// GiveCommand represents the give command
+type GiveCommand struct{}
+
+// Execute executes the give command
+func (c *GiveCommand) Execute(character *types.Character, args string) error {
+	world, ok := character.World.(actWorld)
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	if character.InRoom == nil {
+		return fmt.Errorf("you are not in a room")
+	}
+
+	fields := strings.Fields(args)
+	if len(fields) == 0 {
+		return fmt.Errorf("Give what to who?")
+	}
+
+	// "give <amount> coins <victim>" hands over gold
+	if amount, err := strconv.Atoi(fields[0]); err == nil {
+		return c.giveCoins(world, character, amount, fields[1:])
+	}
+
+	if len(fields) < 2 {
+		return fmt.Errorf("Give what to who?")
+	}
+
+	obj := findObjectInInventory(character, fields[0])
+	if obj == nil {
+		return fmt.Errorf("You do not seem to have anything like that.")
+	}
+
+	if obj.Prototype.ExtraFlags&types.ITEM_NODROP != 0 {
+		return fmt.Errorf("You can't let go of it! Yeech!!")
+	}
+
+	character.InRoom.RLock()
+	vict := findCharacterInRoom(character, fields[1])
+	character.InRoom.RUnlock()
+	if vict == nil {
+		return fmt.Errorf("No one by that name around here.")
+	}
+
+	if types.CarryingNumber(vict)+1 > types.CanCarryNumber(vict) {
+		world.Act("$N seems to have $S hands full.", false, character, nil, vict, types.TO_CHAR)
+		return nil
+	}
+
+	if types.CarryingWeight(vict)+types.ObjectWeight(obj) > types.CanCarryWeight(vict) {
+		world.Act("$E can't carry that much weight.", false, character, nil, vict, types.TO_CHAR)
+		return nil
+	}
+
+	// Move the object from the giver's inventory to the victim's
+	for i, o := range character.Inventory {
+		if o == obj {
+			character.Inventory = append(character.Inventory[:i], character.Inventory[i+1:]...)
+			break
+		}
+	}
+	obj.CarriedBy = vict
+	vict.Inventory = append(vict.Inventory, obj)
+
+	world.Act("$n gives $p to $N.", true, character, obj, vict, types.TO_NOTVICT)
+	world.Act("$n gives you $p.", false, character, obj, vict, types.TO_VICT)
+	character.SendMessage("Ok.\r\n")
+
+	ai.HandleReceive(vict, character, obj, 0)
+	return nil
+}
+
+// giveCoins gives an amount of gold to someone in the room
+func (c *GiveCommand) giveCoins(world actWorld, character *types.Character, amount int, fields []string) error {
+	if len(fields) == 0 || (!strings.EqualFold(fields[0], "coins") && !strings.EqualFold(fields[0], "coin")) {
+		return fmt.Errorf("Sorry, you can't do that (yet)...")
+	}
+
+	if amount <= 0 {
+		return fmt.Errorf("Sorry, you can't do that!")
+	}
+
+	if character.Gold < amount {
+		return fmt.Errorf("You haven't got that many coins!")
+	}
+
+	if len(fields) < 2 {
+		return fmt.Errorf("To who?")
+	}
+
+	character.InRoom.RLock()
+	vict := findCharacterInRoom(character, fields[1])
+	character.InRoom.RUnlock()
+	if vict == nil {
+		return fmt.Errorf("To who?")
+	}
+
+	character.Gold -= amount
+	vict.Gold += amount
+
+	world.Act(fmt.Sprintf("$n gives you %d gold coins.", amount), false, character, nil, vict, types.TO_VICT)
+	world.Act("$n gives some gold to $N.", true, character, nil, vict, types.TO_NOTVICT)
+	character.SendMessage("Ok.\r\n")
+
+	ai.HandleReceive(vict, character, nil, amount)
+	return nil
+}
+
+// Name returns the name of the command
+func (c *GiveCommand) Name() string {
+	return "give"
+}
+
+// Aliases returns the aliases of the command
+func (c *GiveCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *GiveCommand) MinPosition() int {
+	return types.POS_RESTING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *GiveCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *GiveCommand) LogCommand() bool {
+	return false
+}
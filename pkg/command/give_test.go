@@ -0,0 +1,95 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+	"github.com/wltechblog/DikuGo/pkg/world"
+)
+
+func TestGiveObjectToNPC(t *testing.T) {
+	w, err := world.NewWorld(nil, world.NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	var received string
+	pulsed := false
+	w.AddMobilePrototype(&types.Mobile{
+		VNUM:      3060,
+		Name:      "guard",
+		ShortDesc: "the guard",
+		Level:     5,
+		Abilities: [6]int{types.ABILITY_STR: 16, types.ABILITY_DEX: 10},
+		Functions: []func(*types.Character, string) bool{
+			func(mob *types.Character, argument string) bool {
+				pulsed = true
+				return true
+			},
+		},
+		Receivers: []func(*types.Character, string) bool{
+			func(mob *types.Character, argument string) bool {
+				received = argument
+				return true
+			},
+		},
+	})
+
+	room := &types.Room{VNUM: 3001}
+	giver := &types.Character{Name: "Alice", Position: types.POS_STANDING, World: w}
+	guard := w.CreateMobFromPrototype(3060, nil)
+	if guard == nil {
+		t.Fatal("Failed to create the guard")
+	}
+	w.CharToRoom(giver, room)
+	w.CharToRoom(guard, room)
+
+	letter := &types.ObjectInstance{Prototype: &types.Object{VNUM: 3100, Name: "letter", ShortDesc: "a letter", Weight: 1}, CarriedBy: giver}
+	cursed := &types.ObjectInstance{Prototype: &types.Object{VNUM: 3101, Name: "ring", ShortDesc: "a ring", ExtraFlags: types.ITEM_NODROP}, CarriedBy: giver}
+	anvil := &types.ObjectInstance{Prototype: &types.Object{VNUM: 3102, Name: "anvil", ShortDesc: "an anvil", Weight: 500}, CarriedBy: giver}
+	giver.Inventory = []*types.ObjectInstance{letter, cursed, anvil}
+
+	cmd := &GiveCommand{}
+	if err := cmd.Execute(giver, "letter guard"); err != nil {
+		t.Fatalf("Expected give to succeed, got %v", err)
+	}
+	if len(guard.Inventory) != 1 || guard.Inventory[0] != letter || letter.CarriedBy != guard {
+		t.Error("Expected the guard to carry the letter")
+	}
+	if received != "give Alice 3100" {
+		t.Errorf("Expected the guard's procedure to be told about the letter, got %q", received)
+	}
+	if pulsed {
+		t.Error("Expected giving not to run the guard's pulse procedure")
+	}
+
+	if err := cmd.Execute(giver, "ring guard"); err == nil || err.Error() != "You can't let go of it! Yeech!!" {
+		t.Errorf("Expected cursed ring to stay, got %v", err)
+	}
+
+	// The anvil is more than the guard can carry
+	cmd.Execute(giver, "anvil guard")
+	if anvil.CarriedBy != giver {
+		t.Error("Expected the anvil to be too heavy for the guard")
+	}
+}
+
+func TestGiveCoins(t *testing.T) {
+	world := &MockWorldForSocial{}
+	room := &types.Room{VNUM: 3001}
+	giver := &types.Character{Name: "Alice", Position: types.POS_STANDING, InRoom: room, World: world, Gold: 100}
+	taker := &types.Character{Name: "Bob", Position: types.POS_STANDING, InRoom: room, World: world}
+	room.Characters = []*types.Character{giver, taker}
+
+	cmd := &GiveCommand{}
+	if err := cmd.Execute(giver, "40 coins bob"); err != nil {
+		t.Fatalf("Expected give to succeed, got %v", err)
+	}
+	if giver.Gold != 60 || taker.Gold != 40 {
+		t.Errorf("Expected 60/40 gold, got %d/%d", giver.Gold, taker.Gold)
+	}
+
+	if err := cmd.Execute(giver, "100 coins bob"); err == nil || err.Error() != "You haven't got that many coins!" {
+		t.Errorf("Expected to be refused, got %v", err)
+	}
+}
@@ -0,0 +1,267 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// GroupCommand represents the group command
+type GroupCommand struct{}
+
+// Execute executes the group command
+func (c *GroupCommand) Execute(character *types.Character, args string) error {
+	world, ok := character.World.(followWorld)
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	name := strings.TrimSpace(args)
+	if name == "" {
+		return showGroup(character)
+	}
+
+	// Only the head of a group can enroll members
+	if character.Following != nil {
+		return fmt.Errorf("You can not enroll group members without being head of a group.")
+	}
+
+	if character.InRoom == nil {
+		return fmt.Errorf("you are not in a room")
+	}
+
+	var victim *types.Character
+	if strings.EqualFold(name, "self") || strings.EqualFold(name, "me") {
+		victim = character
+	} else {
+		character.InRoom.RLock()
+		victim = findCharacterInRoom(character, name)
+		character.InRoom.RUnlock()
+	}
+	if victim == nil {
+		return fmt.Errorf("No one here by that name.")
+	}
+
+	if victim != character && victim.Following != character {
+		world.Act("$N must follow you, to enter the group", false, character, nil, victim, types.TO_CHAR)
+		return nil
+	}
+
+	if isGrouped(victim) {
+		removeFromGroup(world, character, victim)
+	} else {
+		world.Act("$n is now a member of $N's group.", false, victim, nil, character, types.TO_ROOM)
+		world.Act("You are now a member of the group.", false, victim, nil, nil, types.TO_CHAR)
+		victim.AffectedBy |= types.AFF_GROUP
+	}
+
+	return nil
+}
+
+// Name returns the name of the command
+func (c *GroupCommand) Name() string {
+	return "group"
+}
+
+// Aliases returns the aliases of the command
+func (c *GroupCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *GroupCommand) MinPosition() int {
+	return types.POS_SLEEPING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *GroupCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *GroupCommand) LogCommand() bool {
+	return false
+}
+
+// UngroupCommand represents the ungroup command
+type UngroupCommand struct{}
+
+// Execute executes the ungroup command
+func (c *UngroupCommand) Execute(character *types.Character, args string) error {
+	world, ok := character.World.(followWorld)
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	if !isGrouped(character) {
+		return fmt.Errorf("But you are a member of no group?!")
+	}
+
+	// Members leave the group by no longer following its head
+	if character.Following != nil {
+		if character.AffectedBy&types.AFF_CHARM != 0 {
+			world.Act("But you only feel like following $N!", false, character, nil, character.Following, types.TO_CHAR)
+			return nil
+		}
+		world.StopFollower(character)
+		return nil
+	}
+
+	name := strings.TrimSpace(args)
+	if name == "" {
+		// The head of the group disbands it
+		for _, follower := range character.Followers {
+			if isGrouped(follower) {
+				removeFromGroup(world, character, follower)
+			}
+		}
+		character.AffectedBy &= ^types.AFF_GROUP
+		return fmt.Errorf("You have disbanded the group.")
+	}
+
+	var victim *types.Character
+	for _, follower := range character.Followers {
+		if isGrouped(follower) && strings.Contains(strings.ToLower(follower.Name), strings.ToLower(name)) {
+			victim = follower
+			break
+		}
+	}
+	if victim == nil {
+		return fmt.Errorf("There is no group member by that name.")
+	}
+
+	removeFromGroup(world, character, victim)
+	return nil
+}
+
+// Name returns the name of the command
+func (c *UngroupCommand) Name() string {
+	return "ungroup"
+}
+
+// Aliases returns the aliases of the command
+func (c *UngroupCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *UngroupCommand) MinPosition() int {
+	return types.POS_SLEEPING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *UngroupCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *UngroupCommand) LogCommand() bool {
+	return false
+}
+
+// GtellCommand represents the gtell command
+type GtellCommand struct{}
+
+// Execute executes the gtell command
+func (c *GtellCommand) Execute(character *types.Character, args string) error {
+	world, ok := character.World.(followWorld)
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	if !isGrouped(character) {
+		return fmt.Errorf("You don't have a group to talk to!")
+	}
+
+	message := strings.TrimSpace(args)
+	if message == "" {
+		return fmt.Errorf("What do you want to group tell?!")
+	}
+
+	msg := fmt.Sprintf("$n tells the group '%s'", message)
+	for _, member := range groupMembers(character) {
+		if member != character {
+			world.Act(msg, false, character, nil, member, types.TO_VICT)
+		}
+	}
+
+	return fmt.Errorf("You tell the group '%s'", message)
+}
+
+// Name returns the name of the command
+func (c *GtellCommand) Name() string {
+	return "gtell"
+}
+
+// Aliases returns the aliases of the command
+func (c *GtellCommand) Aliases() []string {
+	return []string{"gt"}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *GtellCommand) MinPosition() int {
+	return types.POS_SLEEPING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *GtellCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *GtellCommand) LogCommand() bool {
+	return false
+}
+
+// isGrouped returns true if the character is a member of a group
+func isGrouped(ch *types.Character) bool {
+	return ch.AffectedBy&types.AFF_GROUP != 0
+}
+
+// groupMembers returns the grouped members of the character's group, head
+// of the group first
+func groupMembers(ch *types.Character) []*types.Character {
+	leader := ch
+	if ch.Following != nil {
+		leader = ch.Following
+	}
+
+	var members []*types.Character
+	if isGrouped(leader) {
+		members = append(members, leader)
+	}
+	for _, follower := range leader.Followers {
+		if isGrouped(follower) {
+			members = append(members, follower)
+		}
+	}
+	return members
+}
+
+// removeFromGroup takes a member out of the leader's group
+func removeFromGroup(world followWorld, leader, member *types.Character) {
+	world.Act("$n has been kicked out of $N's group!", false, member, nil, leader, types.TO_ROOM)
+	world.Act("You are no longer a member of the group!", false, member, nil, nil, types.TO_CHAR)
+	member.AffectedBy &= ^types.AFF_GROUP
+}
+
+// showGroup lists the members of the character's group
+func showGroup(character *types.Character) error {
+	if !isGrouped(character) {
+		return fmt.Errorf("But you are a member of no group?!")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Your group consists of:\r\n")
+	for i, member := range groupMembers(character) {
+		sb.WriteString(fmt.Sprintf("     [%4dH %4dM %4dV] %s",
+			member.HP, member.ManaPoints, member.MovePoints, getSpeakerName(member)))
+		if i == 0 && member.Following == nil {
+			sb.WriteString(" (Head of group)")
+		}
+		sb.WriteString("\r\n")
+	}
+
+	return fmt.Errorf("%s", strings.TrimRight(sb.String(), "\r\n"))
+}
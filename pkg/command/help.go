@@ -2,91 +2,188 @@ package command
 
 import (
 	"fmt"
+	"os"
+	"sort"
 	"strings"
+	"sync"
 
+	"github.com/wltechblog/DikuGo/pkg/storage"
 	"github.com/wltechblog/DikuGo/pkg/types"
 )
 
+// helpKeyword is one keyword in the help index
+type helpKeyword struct {
+	keyword string
+	entry   *types.HelpEntry
+}
+
 // HelpCommand represents the help command
 type HelpCommand struct {
 	// Registry is the command registry
 	Registry *Registry
+
+	index  []helpKeyword // Sorted by keyword
+	screen string        // Shown for a bare "help"
+	mutex  sync.RWMutex
+}
+
+// Load loads the help table and the help screen, replacing any loaded
+// before. It returns the number of help entries.
+func (c *HelpCommand) Load(tableFile, screenFile string) (int, error) {
+	entries, err := storage.ParseHelpTable(tableFile)
+	if err != nil {
+		return 0, err
+	}
+
+	screen, err := os.ReadFile(screenFile)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read help screen: %w", err)
+	}
+
+	var index []helpKeyword
+	for _, entry := range entries {
+		for _, keyword := range entry.Keywords {
+			index = append(index, helpKeyword{keyword: strings.ToLower(keyword), entry: entry})
+		}
+	}
+	sort.SliceStable(index, func(i, j int) bool {
+		return index[i].keyword < index[j].keyword
+	})
+
+	c.mutex.Lock()
+	c.index = index
+	c.screen = string(screen)
+	c.mutex.Unlock()
+
+	return len(entries), nil
 }
 
 // Execute executes the help command
 func (c *HelpCommand) Execute(character *types.Character, args string) error {
+	args = strings.ToLower(strings.Join(strings.Fields(args), " "))
+
 	// If no arguments, show general help
 	if args == "" {
 		return c.showGeneralHelp(character)
 	}
 
-	// Show help for a specific command
-	return c.showCommandHelp(character, args)
-}
+	if entry := c.findEntry(args); entry != nil {
+		return fmt.Errorf("%s", strings.ReplaceAll(entry.Text, "\n", "\r\n"))
+	}
 
-// showGeneralHelp shows general help
-func (c *HelpCommand) showGeneralHelp(character *types.Character) error {
-	// Build the help text
 	var sb strings.Builder
+	sb.WriteString("There is no help on that word.")
+	if suggestions := c.suggestCommands(args); len(suggestions) > 0 {
+		sb.WriteString(fmt.Sprintf("\r\nDid you mean: %s?", strings.Join(suggestions, ", ")))
+	}
+	return fmt.Errorf("%s", sb.String())
+}
 
-	sb.WriteString("\r\nAvailable commands:\r\n")
-	sb.WriteString("------------------\r\n")
-
-	// Get all commands
-	commands := make(map[string]bool)
-	for name, cmd := range c.Registry.commands {
-		// Skip aliases
-		if name == cmd.Name() {
-			commands[name] = true
+// findEntry finds the help entry for the given words. A keyword matches
+// when each word is the start of the keyword's word in the same place, so
+// "mag us" finds "MAGIC USER". An exact match wins over a partial one.
+func (c *HelpCommand) findEntry(args string) *types.HelpEntry {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	words := strings.Fields(args)
+	var partial *types.HelpEntry
+	for _, kw := range c.index {
+		if kw.keyword == args {
+			return kw.entry
+		}
+		if partial == nil && helpKeywordMatches(kw.keyword, words) {
+			partial = kw.entry
 		}
 	}
+	return partial
+}
 
-	// Add each command to the list
-	var commandList []string
-	for name := range commands {
-		commandList = append(commandList, name)
+// helpKeywordMatches returns true if each word starts the keyword's word
+// in the same place
+func helpKeywordMatches(keyword string, words []string) bool {
+	keywordWords := strings.Fields(keyword)
+	if len(words) > len(keywordWords) {
+		return false
 	}
+	for i, word := range words {
+		if !strings.HasPrefix(keywordWords[i], word) {
+			return false
+		}
+	}
+	return true
+}
 
-	// Sort the commands
-	// TODO: Sort the commands
+// suggestCommands returns the names of registered commands close to what
+// was asked for
+func (c *HelpCommand) suggestCommands(args string) []string {
+	word := strings.Fields(args)[0]
 
-	// Add the commands to the help text
-	sb.WriteString(strings.Join(commandList, ", "))
-	sb.WriteString("\r\n\r\n")
-	sb.WriteString("Type 'help <command>' for help on a specific command.\r\n")
+	var suggestions []string
+	for _, name := range c.commandNames() {
+		if strings.HasPrefix(name, word) || editDistance(name, word) <= 2 {
+			suggestions = append(suggestions, name)
+		}
+	}
+	return suggestions
+}
 
-	// Send the help text to the character
-	// TODO: Implement a way to send messages to characters
-	// For now, just return the help text as an error
-	return fmt.Errorf("%s", sb.String())
+// commandNames returns the sorted names of the registered commands, without
+// their aliases
+func (c *HelpCommand) commandNames() []string {
+	var names []string
+	for name, cmd := range c.Registry.Commands() {
+		if name == cmd.Name() {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
 }
 
-// showCommandHelp shows help for a specific command
-func (c *HelpCommand) showCommandHelp(character *types.Character, commandName string) error {
-	// Find the command
-	cmd := c.Registry.Find(commandName)
-	if cmd == nil {
-		return fmt.Errorf("no help available for '%s'", commandName)
+// editDistance returns the number of single letter edits between two words
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
 	}
 
-	// Build the help text
-	var sb strings.Builder
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
 
-	sb.WriteString(fmt.Sprintf("\r\nHelp for '%s':\r\n", cmd.Name()))
-	sb.WriteString("----------------\r\n")
+	return prev[len(b)]
+}
 
-	// Add the command description
-	// TODO: Add command descriptions
-	sb.WriteString("No description available.\r\n")
+// showGeneralHelp shows the help screen, or the list of commands if no
+// help screen is loaded
+func (c *HelpCommand) showGeneralHelp(character *types.Character) error {
+	c.mutex.RLock()
+	screen := c.screen
+	c.mutex.RUnlock()
 
-	// Add the command aliases
-	if len(cmd.Aliases()) > 0 {
-		sb.WriteString(fmt.Sprintf("\r\nAliases: %s\r\n", strings.Join(cmd.Aliases(), ", ")))
+	if screen != "" {
+		return fmt.Errorf("%s", strings.ReplaceAll(strings.TrimRight(screen, "\n"), "\n", "\r\n"))
 	}
 
-	// Send the help text to the character
-	// TODO: Implement a way to send messages to characters
-	// For now, just return the help text as an error
+	// Build the help text
+	var sb strings.Builder
+
+	sb.WriteString("\r\nAvailable commands:\r\n")
+	sb.WriteString("------------------\r\n")
+	sb.WriteString(strings.Join(c.commandNames(), ", "))
+	sb.WriteString("\r\n\r\n")
+	sb.WriteString("Type 'help <command>' for help on a specific command.\r\n")
+
 	return fmt.Errorf("%s", sb.String())
 }
 
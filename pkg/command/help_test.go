@@ -0,0 +1,48 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+func TestHelpCommand(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(&LookCommand{})
+	registry.Register(&LockCommand{})
+	registry.Register(&QuitCommand{})
+
+	cmd := &HelpCommand{Registry: registry}
+	if _, err := cmd.Load("../../lib/help_table", "../../lib/help"); err != nil {
+		t.Fatalf("Failed to load help: %v", err)
+	}
+	ch := &types.Character{Name: "Alice"}
+
+	tests := []struct {
+		args string
+		want string
+	}{
+		{"", "Further information available by HELP <keyword>"},
+		{"sneak", "Used for sneaking"},
+		{"SNE", "Used for sneaking"},
+		{"pick locks", "For picking locks."},
+		{"mag us", "MAGIC USER"},
+		{"wizard", "MAGIC USER"},
+		{"lok", "There is no help on that word.\r\nDid you mean: lock, look?"},
+		{"xyzzy", "There is no help on that word."},
+	}
+
+	for _, tt := range tests {
+		err := cmd.Execute(ch, tt.args)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("help %q: expected %q, got %v", tt.args, tt.want, err)
+		}
+	}
+
+	// Without a help screen the commands are listed in order
+	bare := &HelpCommand{Registry: registry}
+	if err := bare.Execute(ch, ""); err == nil || !strings.Contains(err.Error(), "lock, look, quit") {
+		t.Errorf("Expected a sorted command list, got %v", err)
+	}
+}
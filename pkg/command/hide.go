@@ -0,0 +1,125 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// stealthWorld is the part of the world needed to hide and sneak
+type stealthWorld interface {
+	CanUseSkill(*types.Character, int) (bool, string)
+	CheckSkillSuccess(*types.Character, int) bool
+	ImproveSkill(*types.Character, int, bool)
+	AffectToChar(*types.Character, *types.Affect)
+	AffectFromChar(*types.Character, int)
+}
+
+// HideCommand represents the hide command
+type HideCommand struct{}
+
+// Execute executes the hide command
+func (c *HideCommand) Execute(character *types.Character, args string) error {
+	world, ok := character.World.(stealthWorld)
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	if can, reason := world.CanUseSkill(character, types.SKILL_HIDE); !can {
+		return fmt.Errorf("%s", reason)
+	}
+
+	character.SendMessage("You attempt to hide yourself.\r\n")
+	character.AffectedBy &^= types.AFF_HIDE
+
+	// Whether it worked is for others to find out
+	success := world.CheckSkillSuccess(character, types.SKILL_HIDE)
+	world.ImproveSkill(character, types.SKILL_HIDE, success)
+	if success {
+		character.AffectedBy |= types.AFF_HIDE
+	}
+
+	return nil
+}
+
+// Name returns the name of the command
+func (c *HideCommand) Name() string {
+	return "hide"
+}
+
+// Aliases returns the aliases of the command
+func (c *HideCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *HideCommand) MinPosition() int {
+	return types.POS_STANDING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *HideCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *HideCommand) LogCommand() bool {
+	return false
+}
+
+// SneakCommand represents the sneak command
+type SneakCommand struct{}
+
+// Execute executes the sneak command
+func (c *SneakCommand) Execute(character *types.Character, args string) error {
+	world, ok := character.World.(stealthWorld)
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	if can, reason := world.CanUseSkill(character, types.SKILL_SNEAK); !can {
+		return fmt.Errorf("%s", reason)
+	}
+
+	character.SendMessage("Ok, you'll try to move silently for a while.\r\n")
+	world.AffectFromChar(character, types.SkillAffectType(types.SKILL_SNEAK))
+
+	// Whether it worked is for others to find out
+	success := world.CheckSkillSuccess(character, types.SKILL_SNEAK)
+	world.ImproveSkill(character, types.SKILL_SNEAK, success)
+	if success {
+		world.AffectToChar(character, &types.Affect{
+			Type:      types.SkillAffectType(types.SKILL_SNEAK),
+			Duration:  character.Level,
+			Location:  types.APPLY_NONE,
+			Bitvector: types.AFF_SNEAK,
+		})
+	}
+
+	return nil
+}
+
+// Name returns the name of the command
+func (c *SneakCommand) Name() string {
+	return "sneak"
+}
+
+// Aliases returns the aliases of the command
+func (c *SneakCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *SneakCommand) MinPosition() int {
+	return types.POS_STANDING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *SneakCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *SneakCommand) LogCommand() bool {
+	return false
+}
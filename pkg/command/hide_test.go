@@ -0,0 +1,112 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// MockWorldForStealth implements the interfaces needed by hide and sneak
+type MockWorldForStealth struct {
+	success bool
+}
+
+func (m *MockWorldForStealth) CanUseSkill(ch *types.Character, skill int) (bool, string) {
+	return true, ""
+}
+
+func (m *MockWorldForStealth) CheckSkillSuccess(ch *types.Character, skill int) bool {
+	return m.success
+}
+
+func (m *MockWorldForStealth) ImproveSkill(ch *types.Character, skill int, success bool) {}
+
+func (m *MockWorldForStealth) AffectToChar(ch *types.Character, af *types.Affect) {
+	newAffect := *af
+	newAffect.Next = ch.Affected
+	ch.Affected = &newAffect
+	ch.AffectedBy |= af.Bitvector
+}
+
+func (m *MockWorldForStealth) AffectFromChar(ch *types.Character, spellType int) {
+	if ch.Affected != nil && ch.Affected.Type == spellType {
+		ch.AffectedBy &^= ch.Affected.Bitvector
+		ch.Affected = ch.Affected.Next
+	}
+}
+
+func (m *MockWorldForStealth) CanSee(viewer, target *types.Character) bool {
+	return viewer == target || target.AffectedBy&types.AFF_HIDE == 0 || viewer.AffectedBy&types.AFF_SENSE_LIFE != 0
+}
+
+func TestHideAndSneak(t *testing.T) {
+	world := &MockWorldForStealth{success: true}
+	thief := &types.Character{Name: "Thief", Level: 10, Position: types.POS_STANDING, World: world}
+
+	if err := (&HideCommand{}).Execute(thief, ""); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if thief.AffectedBy&types.AFF_HIDE == 0 {
+		t.Error("Expected the thief to be hidden")
+	}
+
+	if err := (&SneakCommand{}).Execute(thief, ""); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if thief.AffectedBy&types.AFF_SNEAK == 0 || thief.Affected == nil || thief.Affected.Duration != thief.Level {
+		t.Error("Expected the thief to be sneaking for as many hours as its level")
+	}
+
+	world.success = false
+	if err := (&SneakCommand{}).Execute(thief, ""); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if thief.AffectedBy&types.AFF_SNEAK != 0 {
+		t.Error("Expected a failed sneak to leave the thief sneaking no longer")
+	}
+}
+
+func TestHiddenCharactersAreNotListed(t *testing.T) {
+	world := &MockWorldForStealth{}
+	room := &types.Room{VNUM: 3001, Name: "Alley"}
+	viewer := &types.Character{Name: "Alice", Position: types.POS_STANDING, InRoom: room, World: world}
+	thief := &types.Character{Name: "Thief", Title: "the Sly", Position: types.POS_STANDING, InRoom: room, World: world,
+		AffectedBy: types.AFF_HIDE}
+	room.Characters = []*types.Character{viewer, thief}
+
+	err := (&LookCommand{}).Execute(viewer, "")
+	if err == nil || strings.Contains(err.Error(), "Thief") {
+		t.Errorf("Expected the hidden thief not to be listed, got %v", err)
+	}
+	if err := (&LookCommand{}).Execute(viewer, "thief"); err != nil && strings.Contains(err.Error(), "Thief") {
+		t.Errorf("Expected not to be able to look at the hidden thief, got %v", err)
+	}
+
+	viewer.AffectedBy |= types.AFF_SENSE_LIFE
+	if err := (&LookCommand{}).Execute(viewer, ""); err == nil || !strings.Contains(err.Error(), "Thief the Sly is here.") {
+		t.Errorf("Expected sense life to reveal the thief, got %v", err)
+	}
+}
+
+func TestActingBringsCharacterOutOfHiding(t *testing.T) {
+	world := &MockWorldForStealth{}
+	room := &types.Room{VNUM: 3001, Name: "Alley"}
+	thief := &types.Character{Name: "Thief", Position: types.POS_STANDING, InRoom: room, World: world,
+		AffectedBy: types.AFF_HIDE}
+	room.Characters = []*types.Character{thief}
+
+	registry := NewRegistry()
+	registry.Register(&LookCommand{})
+	registry.Register(&SitCommand{})
+
+	registry.Execute(thief, "look")
+	if thief.AffectedBy&types.AFF_HIDE == 0 {
+		t.Error("Expected looking around to keep the thief hidden")
+	}
+
+	registry.Execute(thief, "sit")
+	if thief.AffectedBy&types.AFF_HIDE != 0 {
+		t.Error("Expected sitting down to bring the thief out of hiding")
+	}
+}
@@ -117,11 +117,8 @@ func (c *HoldCommand) holdLight(character *types.Character, obj *types.ObjectIns
 		}
 	}
 
-	// Increase room light if the light source is lit
-	if obj.Prototype.Value[2] > 0 && character.InRoom != nil {
-		// TODO: Implement room lighting system
-		// character.InRoom.Light++
-	}
+	// A lit light brightens the room
+	updateRoomLight(character)
 
 	// Send a message to the character
 	return fmt.Errorf("Ok.\r\n")
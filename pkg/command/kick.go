@@ -0,0 +1,116 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// KickCommand represents the kick command
+type KickCommand struct {
+	// CombatManager is the combat manager
+	CombatManager CombatManagerInterface
+}
+
+// Execute executes the kick command
+func (c *KickCommand) Execute(character *types.Character, args string) error {
+	// Check if the character is in a room
+	if character.InRoom == nil {
+		return fmt.Errorf("you are not in a room")
+	}
+
+	// Get the world interface
+	world, ok := character.World.(interface {
+		CanUseSkill(*types.Character, int) (bool, string)
+		CheckSkillSuccess(*types.Character, int) bool
+		UseSkill(*types.Character, int)
+		ImproveSkill(*types.Character, int, bool)
+		AddDelay(*types.Character, int)
+		Damage(*types.Character, *types.Character, int, int)
+	})
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	// Check if the character can use the kick skill
+	if can, reason := world.CanUseSkill(character, types.SKILL_KICK); !can {
+		return fmt.Errorf("%s", reason)
+	}
+
+	// Find the target
+	var victim *types.Character
+	if args == "" {
+		// If no target specified, use current opponent
+		if character.Fighting == nil {
+			return fmt.Errorf("kick whom?")
+		}
+		victim = character.Fighting
+	} else {
+		// Find the target in the room
+		victim = findCharacterInRoom(character, args)
+		if victim == nil {
+			return fmt.Errorf("they aren't here")
+		}
+	}
+
+	// Check if the target is the character
+	if victim == character {
+		return fmt.Errorf("aren't we funny today...")
+	}
+
+	// Mark the skill as used (set cooldown)
+	world.UseSkill(character, types.SKILL_KICK)
+
+	// Check if the kick is successful
+	success := world.CheckSkillSuccess(character, types.SKILL_KICK)
+
+	// Give a chance to improve the skill
+	world.ImproveSkill(character, types.SKILL_KICK, success)
+
+	// A kick does half the kicker's level in damage
+	damage := 0
+	if success {
+		damage = character.Level / 2
+		if damage < 1 {
+			damage = 1
+		}
+	}
+
+	// Damage shows everyone how the kick went, even when it misses
+	world.Damage(character, victim, damage, types.SkillAttackType(types.SKILL_KICK))
+
+	// Kicking leaves the kicker off balance for a few rounds
+	world.AddDelay(character, types.GetSkillDelay(types.SKILL_KICK))
+
+	// Start combat if not already fighting
+	if victim.Position != types.POS_DEAD && (character.Fighting == nil || character.Fighting != victim) {
+		c.CombatManager.StartCombat(character, victim)
+	}
+
+	return nil
+}
+
+// Name returns the name of the command
+func (c *KickCommand) Name() string {
+	return "kick"
+}
+
+// Aliases returns the aliases of the command
+func (c *KickCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *KickCommand) MinPosition() int {
+	return types.POS_FIGHTING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *KickCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *KickCommand) LogCommand() bool {
+	return false
+}
@@ -0,0 +1,106 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// MockWorldForKick implements the interface needed by KickCommand
+type MockWorldForKick struct {
+	MockWorldForSocial
+	success    bool
+	improved   bool
+	delay      int
+	damaged    bool
+	attackType int
+}
+
+func (m *MockWorldForKick) CanUseSkill(ch *types.Character, skill int) (bool, string) {
+	return true, ""
+}
+
+func (m *MockWorldForKick) CheckSkillSuccess(ch *types.Character, skill int) bool {
+	return m.success
+}
+
+func (m *MockWorldForKick) UseSkill(ch *types.Character, skill int) {}
+
+func (m *MockWorldForKick) ImproveSkill(ch *types.Character, skill int, success bool) {
+	m.improved = true
+}
+
+func (m *MockWorldForKick) AddDelay(ch *types.Character, delay int) {
+	m.delay += delay
+}
+
+func (m *MockWorldForKick) Damage(ch *types.Character, victim *types.Character, damage int, attackType int) {
+	m.damaged = true
+	m.attackType = attackType
+	victim.HP -= damage
+	if victim.HP <= 0 {
+		victim.HP = 0
+		victim.Position = types.POS_DEAD
+	}
+}
+
+func TestKickCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		success  bool
+		victimHP int
+		expectHP int
+	}{
+		{"miss", false, 20, 20},
+		{"hit", true, 20, 10},
+		{"kill", true, 5, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			world := &MockWorldForKick{success: tt.success}
+			room := &types.Room{VNUM: 3001}
+			kicker := &types.Character{Name: "Alice", Level: 20, Position: types.POS_STANDING, InRoom: room, World: world}
+			victim := &types.Character{Name: "fido", ShortDesc: "a fido", IsNPC: true, Level: 1,
+				HP: tt.victimHP, Position: types.POS_STANDING, InRoom: room, World: world}
+			room.Characters = []*types.Character{kicker, victim}
+
+			cmd := &KickCommand{CombatManager: &MockCombatManager{}}
+			if err := cmd.Execute(kicker, "fido"); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if victim.HP != tt.expectHP {
+				t.Errorf("Expected victim HP %d, got %d", tt.expectHP, victim.HP)
+			}
+			if !world.damaged || world.attackType != types.SkillAttackType(types.SKILL_KICK) {
+				t.Errorf("Expected the kick to go through damage as a kick, got attack type %d", world.attackType)
+			}
+			if !world.improved {
+				t.Error("Expected the kick skill to get a chance to improve")
+			}
+			if world.delay != types.GetSkillDelay(types.SKILL_KICK) {
+				t.Errorf("Expected a delay of %d, got %d", types.GetSkillDelay(types.SKILL_KICK), world.delay)
+			}
+
+			if tt.expectHP > 0 && kicker.Fighting != victim {
+				t.Error("Expected the kicker to be fighting the victim")
+			}
+		})
+	}
+}
+
+func TestKickCommandSelf(t *testing.T) {
+	world := &MockWorldForKick{success: true}
+	room := &types.Room{VNUM: 3001}
+	kicker := &types.Character{Name: "Alice", Level: 20, Position: types.POS_STANDING, InRoom: room, World: world}
+	room.Characters = []*types.Character{kicker}
+
+	cmd := &KickCommand{CombatManager: &MockCombatManager{}}
+	if err := cmd.Execute(kicker, ""); err == nil || err.Error() != "kick whom?" {
+		t.Errorf("Expected 'kick whom?', got %v", err)
+	}
+	if err := cmd.Execute(kicker, "alice"); err == nil || err.Error() != "aren't we funny today..." {
+		t.Errorf("Expected 'aren't we funny today...', got %v", err)
+	}
+}
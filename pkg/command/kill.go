@@ -33,7 +33,7 @@ func (c *KillCommand) Execute(character *types.Character, args string) error {
 	}
 
 	// Find the target
-	target := findCharacterInRoom(character.InRoom, args)
+	target := findCharacterInRoom(character, args)
 	if target == nil {
 		return fmt.Errorf("they aren't here")
 	}
@@ -83,13 +83,18 @@ func (c *KillCommand) LogCommand() bool {
 	return true
 }
 
-// findCharacterInRoom finds a character in a room by name
-func findCharacterInRoom(room *types.Room, name string) *types.Character {
+// findCharacterInRoom finds a character the viewer can see in the viewer's
+// room by name
+func findCharacterInRoom(viewer *types.Character, name string) *types.Character {
 	// Convert the name to lowercase
 	name = strings.ToLower(name)
 
 	// Check each character in the room
-	for _, ch := range room.Characters {
+	for _, ch := range viewer.InRoom.Characters {
+		if !canSee(viewer, ch) {
+			continue
+		}
+
 		// Check if the character's name contains the search name
 		if strings.Contains(strings.ToLower(ch.Name), name) {
 			return ch
@@ -0,0 +1,58 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// LevelsCommand shows the experience needed for each level and its title
+type LevelsCommand struct{}
+
+// Execute executes the levels command
+func (c *LevelsCommand) Execute(character *types.Character, args string) error {
+	if character.IsNPC {
+		return fmt.Errorf("You ain't nothin' but a hound-dog.")
+	}
+
+	if _, ok := types.ClassTitles[character.Class]; !ok {
+		return fmt.Errorf("Your class has no levels.")
+	}
+
+	var sb strings.Builder
+	for level := 1; level < types.LEVEL_IMMORTAL; level++ {
+		sb.WriteString(fmt.Sprintf("[%2d] %7d-%-7d : %s\r\n",
+			level,
+			types.GetExpForLevel(character.Class, level),
+			types.GetExpForLevel(character.Class, level+1),
+			types.GetClassTitle(character.Class, level, character.Sex)))
+	}
+
+	return fmt.Errorf("%s", strings.TrimRight(sb.String(), "\r\n"))
+}
+
+// Name returns the name of the command
+func (c *LevelsCommand) Name() string {
+	return "levels"
+}
+
+// Aliases returns the aliases of the command
+func (c *LevelsCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *LevelsCommand) MinPosition() int {
+	return types.POS_DEAD
+}
+
+// Level returns the minimum level required to execute the command
+func (c *LevelsCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *LevelsCommand) LogCommand() bool {
+	return false
+}
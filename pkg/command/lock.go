@@ -0,0 +1,343 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// LockCommand represents the lock command
+type LockCommand struct{}
+
+// Execute executes the lock command
+func (c *LockCommand) Execute(character *types.Character, args string) error {
+	world, ok := character.World.(actWorld)
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	obj, dir, err := findLockTarget(character, args, "Lock what?")
+	if err != nil {
+		return err
+	}
+
+	if obj != nil {
+		flags := obj.ContainerFlags()
+		switch {
+		case obj.Prototype.Type != types.ITEM_CONTAINER:
+			return fmt.Errorf("That's not a container.")
+		case flags&types.CONT_CLOSED == 0:
+			return fmt.Errorf("Maybe you should close it first...")
+		case obj.Prototype.Value[2] <= 0:
+			return fmt.Errorf("That thing can't be locked.")
+		case !hasKey(character, obj.Prototype.Value[2]):
+			return fmt.Errorf("You don't seem to have the proper key.")
+		case flags&types.CONT_LOCKED != 0:
+			return fmt.Errorf("It is locked already.")
+		}
+
+		obj.SetContainerFlags(flags | types.CONT_LOCKED)
+		world.Act("$n locks $p - 'cluck', it says.", false, character, obj, nil, types.TO_ROOM)
+		return fmt.Errorf("*Cluck*")
+	}
+
+	exit := character.InRoom.Exits[dir]
+	switch {
+	case !exit.IsDoor():
+		return fmt.Errorf("That's absurd.")
+	case !exit.IsClosed():
+		return fmt.Errorf("You have to close it first, I'm afraid.")
+	case exit.Key <= 0:
+		return fmt.Errorf("There does not seem to be any keyholes.")
+	case !hasKey(character, exit.Key):
+		return fmt.Errorf("You don't have the proper key.")
+	case exit.IsLocked():
+		return fmt.Errorf("It's already locked!")
+	}
+
+	exit.Flags |= types.EX_LOCKED
+	world.Act(fmt.Sprintf("$n locks the %s.", doorKeyword(exit)), false, character, nil, nil, types.TO_ROOM)
+	setOtherSideLocked(character, dir, true)
+	return fmt.Errorf("*Click*")
+}
+
+// Name returns the name of the command
+func (c *LockCommand) Name() string {
+	return "lock"
+}
+
+// Aliases returns the aliases of the command
+func (c *LockCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *LockCommand) MinPosition() int {
+	return types.POS_SITTING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *LockCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *LockCommand) LogCommand() bool {
+	return false
+}
+
+// UnlockCommand represents the unlock command
+type UnlockCommand struct{}
+
+// Execute executes the unlock command
+func (c *UnlockCommand) Execute(character *types.Character, args string) error {
+	world, ok := character.World.(actWorld)
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	obj, dir, err := findLockTarget(character, args, "Unlock what?")
+	if err != nil {
+		return err
+	}
+
+	if obj != nil {
+		flags := obj.ContainerFlags()
+		switch {
+		case obj.Prototype.Type != types.ITEM_CONTAINER:
+			return fmt.Errorf("That's not a container.")
+		case flags&types.CONT_CLOSED == 0:
+			return fmt.Errorf("Silly - it ain't even closed!")
+		case obj.Prototype.Value[2] <= 0:
+			return fmt.Errorf("Odd - you can't seem to find a keyhole.")
+		case !hasKey(character, obj.Prototype.Value[2]):
+			return fmt.Errorf("You don't seem to have the proper key.")
+		case flags&types.CONT_LOCKED == 0:
+			return fmt.Errorf("Oh.. it wasn't locked, after all.")
+		}
+
+		obj.SetContainerFlags(flags &^ types.CONT_LOCKED)
+		world.Act("$n unlocks $p.", false, character, obj, nil, types.TO_ROOM)
+		return fmt.Errorf("*Click*")
+	}
+
+	exit := character.InRoom.Exits[dir]
+	switch {
+	case !exit.IsDoor():
+		return fmt.Errorf("That's absurd.")
+	case !exit.IsClosed():
+		return fmt.Errorf("Heck.. it ain't even closed!")
+	case exit.Key <= 0:
+		return fmt.Errorf("You can't seem to spot any keyholes.")
+	case !hasKey(character, exit.Key):
+		return fmt.Errorf("You do not have the proper key for that.")
+	case !exit.IsLocked():
+		return fmt.Errorf("It's already unlocked, it seems.")
+	}
+
+	exit.Flags &^= types.EX_LOCKED
+	world.Act(fmt.Sprintf("$n unlocks the %s.", doorKeyword(exit)), false, character, nil, nil, types.TO_ROOM)
+	setOtherSideLocked(character, dir, false)
+	return fmt.Errorf("*Click*")
+}
+
+// Name returns the name of the command
+func (c *UnlockCommand) Name() string {
+	return "unlock"
+}
+
+// Aliases returns the aliases of the command
+func (c *UnlockCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *UnlockCommand) MinPosition() int {
+	return types.POS_SITTING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *UnlockCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *UnlockCommand) LogCommand() bool {
+	return false
+}
+
+// PickCommand represents the pick command for picking locks
+type PickCommand struct{}
+
+// Execute executes the pick command
+func (c *PickCommand) Execute(character *types.Character, args string) error {
+	world, ok := character.World.(interface {
+		Act(string, bool, *types.Character, *types.ObjectInstance, *types.Character, int)
+		CheckSkillSuccess(*types.Character, int) bool
+	})
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	obj, dir, err := findLockTarget(character, args, "Pick what?")
+	if err != nil {
+		return err
+	}
+
+	if obj != nil {
+		flags := obj.ContainerFlags()
+		switch {
+		case obj.Prototype.Type != types.ITEM_CONTAINER:
+			return fmt.Errorf("That's not a container.")
+		case flags&types.CONT_CLOSED == 0:
+			return fmt.Errorf("Silly - it isn't even closed!")
+		case obj.Prototype.Value[2] <= 0:
+			return fmt.Errorf("Odd - you can't seem to find a keyhole.")
+		case flags&types.CONT_LOCKED == 0:
+			return fmt.Errorf("Oho! This thing is NOT locked!")
+		case flags&types.CONT_PICKPROOF != 0:
+			return fmt.Errorf("It resists your attempts at picking it.")
+		case !world.CheckSkillSuccess(character, types.SKILL_PICK_LOCK):
+			return fmt.Errorf("You failed to pick the lock.")
+		}
+
+		obj.SetContainerFlags(flags &^ types.CONT_LOCKED)
+		world.Act("$n fiddles with $p.", false, character, obj, nil, types.TO_ROOM)
+		return fmt.Errorf("*Click*")
+	}
+
+	exit := character.InRoom.Exits[dir]
+	switch {
+	case !exit.IsDoor():
+		return fmt.Errorf("That's absurd.")
+	case !exit.IsClosed():
+		return fmt.Errorf("You realize that the door is already open.")
+	case exit.Key <= 0:
+		return fmt.Errorf("You can't seem to spot any lock to pick.")
+	case !exit.IsLocked():
+		return fmt.Errorf("Oh.. it wasn't locked at all.")
+	case exit.IsPickproof():
+		return fmt.Errorf("You seem to be unable to pick this lock.")
+	case !world.CheckSkillSuccess(character, types.SKILL_PICK_LOCK):
+		return fmt.Errorf("You failed to pick the lock.")
+	}
+
+	exit.Flags &^= types.EX_LOCKED
+	world.Act(fmt.Sprintf("$n skillfully picks the lock of the %s.", doorKeyword(exit)), false, character, nil, nil, types.TO_ROOM)
+	setOtherSideLocked(character, dir, false)
+	return fmt.Errorf("The lock quickly yields to your skills.")
+}
+
+// Name returns the name of the command
+func (c *PickCommand) Name() string {
+	return "pick"
+}
+
+// Aliases returns the aliases of the command
+func (c *PickCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *PickCommand) MinPosition() int {
+	return types.POS_STANDING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *PickCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *PickCommand) LogCommand() bool {
+	return false
+}
+
+// findLockTarget finds the container or door the lock commands act on,
+// looking for a container first as open and close do. It returns either
+// the object or the direction of the door.
+func findLockTarget(character *types.Character, args string, usage string) (*types.ObjectInstance, int, error) {
+	finder := &OpenCommand{}
+
+	name, direction := finder.argumentInterpreter(args)
+	if name == "" {
+		return nil, -1, fmt.Errorf("%s", usage)
+	}
+
+	if character.InRoom == nil {
+		return nil, -1, fmt.Errorf("you are not in a room")
+	}
+
+	if obj := finder.findObject(character, strings.TrimSpace(strings.ToLower(args))); obj != nil {
+		return obj, -1, nil
+	}
+
+	dir, err := finder.findDoorWithErrors(character, name, direction)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	return nil, dir, nil
+}
+
+// hasKey returns true if the character carries or holds the key with the
+// given vnum
+func hasKey(character *types.Character, key int) bool {
+	isKey := func(obj *types.ObjectInstance) bool {
+		return obj != nil && obj.Prototype.Type == types.ITEM_KEY && obj.Prototype.VNUM == key
+	}
+
+	for _, obj := range character.Inventory {
+		if isKey(obj) {
+			return true
+		}
+	}
+
+	if len(character.Equipment) > types.WEAR_HOLD && isKey(character.Equipment[types.WEAR_HOLD]) {
+		return true
+	}
+
+	return false
+}
+
+// doorKeyword returns the name a door is known by
+func doorKeyword(exit *types.Exit) string {
+	if fields := strings.Fields(exit.Keywords); len(fields) > 0 {
+		return fields[0]
+	}
+	return "door"
+}
+
+// setOtherSideLocked locks or unlocks the other side of a door so both
+// sides stay in sync
+func setOtherSideLocked(character *types.Character, dir int, locked bool) {
+	world, ok := character.World.(interface {
+		GetRoom(vnum int) *types.Room
+	})
+	if !ok {
+		return
+	}
+
+	exit := character.InRoom.Exits[dir]
+	destRoom := world.GetRoom(exit.DestVnum)
+	if destRoom == nil {
+		return
+	}
+
+	reverseDir := (&OpenCommand{}).getReverseDirection(dir)
+	if reverseDir < 0 {
+		return
+	}
+
+	back := destRoom.Exits[reverseDir]
+	if back == nil || back.DestVnum != character.InRoom.VNUM {
+		return
+	}
+
+	if locked {
+		back.Flags |= types.EX_LOCKED
+	} else {
+		back.Flags &^= types.EX_LOCKED
+	}
+}
@@ -0,0 +1,147 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// MockWorldForLock implements the interfaces needed by the lock commands
+type MockWorldForLock struct {
+	MockWorldForSocial
+	rooms      map[int]*types.Room
+	pickResult bool
+}
+
+func (m *MockWorldForLock) GetRoom(vnum int) *types.Room {
+	return m.rooms[vnum]
+}
+
+func (m *MockWorldForLock) CheckSkillSuccess(ch *types.Character, skill int) bool {
+	return m.pickResult
+}
+
+// newLockTestRooms creates two rooms joined by a closed, locked gate
+func newLockTestRooms() (*MockWorldForLock, *types.Room, *types.Room) {
+	inside := &types.Room{VNUM: 1001}
+	outside := &types.Room{VNUM: 1002}
+	inside.Exits[types.DIR_NORTH] = &types.Exit{Keywords: "gate", Key: 3100, DestVnum: 1002,
+		Flags: types.EX_ISDOOR | types.EX_CLOSED | types.EX_LOCKED}
+	outside.Exits[types.DIR_SOUTH] = &types.Exit{Keywords: "gate", Key: 3100, DestVnum: 1001,
+		Flags: types.EX_ISDOOR | types.EX_CLOSED | types.EX_LOCKED}
+
+	world := &MockWorldForLock{rooms: map[int]*types.Room{1001: inside, 1002: outside}}
+	return world, inside, outside
+}
+
+func TestUnlockAndLockDoorSyncsOtherSide(t *testing.T) {
+	world, inside, outside := newLockTestRooms()
+	character := &types.Character{Name: "Alice", Position: types.POS_STANDING, InRoom: inside, World: world}
+	inside.Characters = []*types.Character{character}
+
+	if err := (&UnlockCommand{}).Execute(character, "gate"); err == nil || err.Error() != "You do not have the proper key for that." {
+		t.Errorf("Expected to need a key, got %v", err)
+	}
+
+	key := &types.ObjectInstance{Prototype: &types.Object{VNUM: 3100, Name: "key", Type: types.ITEM_KEY}}
+	character.Inventory = []*types.ObjectInstance{key}
+
+	if err := (&UnlockCommand{}).Execute(character, "gate"); err == nil || err.Error() != "*Click*" {
+		t.Errorf("Expected to unlock the gate, got %v", err)
+	}
+	if inside.Exits[types.DIR_NORTH].IsLocked() || outside.Exits[types.DIR_SOUTH].IsLocked() {
+		t.Error("Expected both sides of the gate to be unlocked")
+	}
+
+	if err := (&LockCommand{}).Execute(character, "gate"); err == nil || err.Error() != "*Click*" {
+		t.Errorf("Expected to lock the gate, got %v", err)
+	}
+	if !inside.Exits[types.DIR_NORTH].IsLocked() || !outside.Exits[types.DIR_SOUTH].IsLocked() {
+		t.Error("Expected both sides of the gate to be locked")
+	}
+}
+
+func TestPickDoor(t *testing.T) {
+	world, inside, outside := newLockTestRooms()
+	character := &types.Character{Name: "Thief", Position: types.POS_STANDING, InRoom: inside, World: world}
+	inside.Characters = []*types.Character{character}
+
+	if err := (&PickCommand{}).Execute(character, "gate"); err == nil || err.Error() != "You failed to pick the lock." {
+		t.Errorf("Expected a failed pick, got %v", err)
+	}
+
+	world.pickResult = true
+	inside.Exits[types.DIR_NORTH].Flags |= types.EX_PICKPROOF
+	if err := (&PickCommand{}).Execute(character, "gate"); err == nil || err.Error() != "You seem to be unable to pick this lock." {
+		t.Errorf("Expected pickproof gate to resist, got %v", err)
+	}
+
+	inside.Exits[types.DIR_NORTH].Flags &^= types.EX_PICKPROOF
+	if err := (&PickCommand{}).Execute(character, "gate"); err == nil || err.Error() != "The lock quickly yields to your skills." {
+		t.Errorf("Expected to pick the gate, got %v", err)
+	}
+	if inside.Exits[types.DIR_NORTH].IsLocked() || outside.Exits[types.DIR_SOUTH].IsLocked() {
+		t.Error("Expected both sides of the gate to be unlocked")
+	}
+}
+
+func TestLockContainer(t *testing.T) {
+	world, inside, _ := newLockTestRooms()
+	character := &types.Character{Name: "Alice", Position: types.POS_STANDING, InRoom: inside, World: world}
+	inside.Characters = []*types.Character{character}
+
+	chest := &types.ObjectInstance{
+		Prototype: &types.Object{
+			VNUM:  2001,
+			Name:  "chest",
+			Type:  types.ITEM_CONTAINER,
+			Value: [4]int{100, types.CONT_CLOSEABLE, 3101, 0},
+		},
+		Value: [4]int{100, types.CONT_CLOSEABLE, 3101, 0},
+	}
+	key := &types.ObjectInstance{Prototype: &types.Object{VNUM: 3101, Name: "key", Type: types.ITEM_KEY}}
+	character.Inventory = []*types.ObjectInstance{chest, key}
+
+	if err := (&LockCommand{}).Execute(character, "chest"); err == nil || err.Error() != "Maybe you should close it first..." {
+		t.Errorf("Expected to need to close the chest, got %v", err)
+	}
+
+	(&CloseCommand{}).Execute(character, "chest")
+	if err := (&LockCommand{}).Execute(character, "chest"); err == nil || err.Error() != "*Cluck*" {
+		t.Errorf("Expected to lock the chest, got %v", err)
+	}
+
+	// A locked chest can't be opened
+	if err := (&OpenCommand{}).Execute(character, "chest"); err == nil || err.Error() != "it seems to be locked." {
+		t.Errorf("Expected the chest to be locked, got %v", err)
+	}
+
+	if err := (&UnlockCommand{}).Execute(character, "chest"); err == nil || err.Error() != "*Click*" {
+		t.Errorf("Expected to unlock the chest, got %v", err)
+	}
+	if err := (&OpenCommand{}).Execute(character, "chest"); err != nil {
+		t.Errorf("Expected to open the chest, got %v", err)
+	}
+}
+
+func TestContainerKeepsItsOwnState(t *testing.T) {
+	world, inside, _ := newLockTestRooms()
+	character := &types.Character{Name: "Alice", Position: types.POS_STANDING, InRoom: inside, World: world}
+	inside.Characters = []*types.Character{character}
+
+	// The chest starts out closed and locked, but this one has been left open
+	chest := &types.ObjectInstance{Prototype: &types.Object{
+		VNUM:  2001,
+		Name:  "chest",
+		Type:  types.ITEM_CONTAINER,
+		Value: [4]int{100, types.CONT_CLOSED | types.CONT_LOCKED, 3101, 0},
+	}}
+	character.Inventory = []*types.ObjectInstance{chest}
+
+	if chest.ContainerFlags() != 0 {
+		t.Errorf("Expected the open chest to have no flags, got %d", chest.ContainerFlags())
+	}
+	if err := (&UnlockCommand{}).Execute(character, "chest"); err == nil || err.Error() != "Silly - it ain't even closed!" {
+		t.Errorf("Expected the chest to stay open, got %v", err)
+	}
+}
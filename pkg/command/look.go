@@ -13,13 +13,22 @@ type LookCommand struct{}
 
 // Execute executes the look command
 func (c *LookCommand) Execute(character *types.Character, args string) error {
+	// Sleeping characters see nothing
+	if character.Position == types.POS_SLEEPING {
+		return fmt.Errorf("You can't see anything, you're sleeping!")
+	}
+
+	if character.AffectedBy&types.AFF_BLIND != 0 {
+		return fmt.Errorf("You can't see a damned thing, you're blind!")
+	}
+
 	// If no arguments, look at the room
 	if args == "" {
 		return c.lookAtRoom(character)
 	}
 
-	// Look at something in the room
-	return c.lookAtTarget(character, args)
+	// Look at something in the room, "look at board" being "look board"
+	return c.lookAtTarget(character, strings.TrimPrefix(args, "at "))
 }
 
 // lookAtRoom looks at the room the character is in
@@ -29,6 +38,10 @@ func (c *LookCommand) lookAtRoom(character *types.Character) error {
 		return fmt.Errorf("you are not in a room")
 	}
 
+	if tooDark(character) {
+		return fmt.Errorf("It is pitch black...")
+	}
+
 	// Get the room
 	room := character.InRoom
 
@@ -70,7 +83,7 @@ func (c *LookCommand) lookAtRoom(character *types.Character) error {
 
 	// Characters in the room (safely read while holding read lock)
 	for _, ch := range room.Characters {
-		if ch != character {
+		if ch != character && canSee(character, ch) {
 			if ch.IsNPC {
 				sb.WriteString(fmt.Sprintf("%s is here.\r\n", ch.ShortDesc))
 			} else {
@@ -81,6 +94,9 @@ func (c *LookCommand) lookAtRoom(character *types.Character) error {
 
 	// Objects in the room (safely read while holding read lock)
 	for _, obj := range room.Objects {
+		if !canSeeObj(character, obj) {
+			continue
+		}
 		sb.WriteString(fmt.Sprintf("%s is here.\r\n", obj.Prototype.ShortDesc))
 	}
 
@@ -119,21 +135,21 @@ func (c *LookCommand) lookAtTarget(character *types.Character, target string) er
 
 	// Check if the target is a character in the room
 	for _, ch := range room.Characters {
-		if ch != character && strings.Contains(strings.ToLower(ch.Name), strings.ToLower(target)) {
+		if ch != character && strings.Contains(strings.ToLower(ch.Name), strings.ToLower(target)) && canSee(character, ch) {
 			return c.lookAtCharacter(character, ch)
 		}
 	}
 
 	// Check if the target is an object in the room
 	for _, obj := range room.Objects {
-		if strings.Contains(strings.ToLower(obj.Prototype.Name), strings.ToLower(target)) {
+		if strings.Contains(strings.ToLower(obj.Prototype.Name), strings.ToLower(target)) && canSeeObj(character, obj) {
 			return c.lookAtObject(character, obj)
 		}
 	}
 
 	// Check if the target is an object in the character's inventory
 	for _, obj := range character.Inventory {
-		if strings.Contains(strings.ToLower(obj.Prototype.Name), strings.ToLower(target)) {
+		if strings.Contains(strings.ToLower(obj.Prototype.Name), strings.ToLower(target)) && canSeeObj(character, obj) {
 			return c.lookAtObject(character, obj)
 		}
 	}
@@ -161,7 +177,7 @@ func (c *LookCommand) Aliases() []string {
 
 // MinPosition returns the minimum position required to execute the command
 func (c *LookCommand) MinPosition() int {
-	return types.POS_RESTING
+	return types.POS_SLEEPING
 }
 
 // Level returns the minimum level required to execute the command
@@ -213,7 +229,7 @@ func (c *LookCommand) lookInContainer(character *types.Character, containerName
 	containerObj = findObjectInInventory(character, containerName)
 	if containerObj == nil {
 		// Then check room
-		containerObj = findObjectInRoom(character.InRoom, containerName)
+		containerObj = findObjectInRoom(character, containerName)
 	}
 	if containerObj == nil {
 		// Then check equipment
@@ -235,7 +251,7 @@ func (c *LookCommand) lookInContainer(character *types.Character, containerName
 	}
 
 	// Check if the container is closed
-	if containerObj.Prototype.Value[1]&types.CONT_CLOSED != 0 {
+	if containerObj.ContainerFlags()&types.CONT_CLOSED != 0 {
 		return fmt.Errorf("%s is closed", containerObj.Prototype.ShortDesc)
 	}
 
@@ -277,6 +293,20 @@ func (c *LookCommand) lookAtCharacter(character *types.Character, target *types.
 
 // lookAtObject looks at an object
 func (c *LookCommand) lookAtObject(character *types.Character, obj *types.ObjectInstance) error {
+	// Bulletin boards list their messages
+	if world, ok := character.World.(boardWorld); ok && world.IsBoard(obj) {
+		return fmt.Errorf("%s", showBoard(world, obj))
+	}
+
+	// Notes show what is written on them
+	if obj.Prototype.Type == types.ITEM_NOTE {
+		if obj.Prototype.ActionDesc == "" {
+			return fmt.Errorf("It's blank.")
+		}
+		character.PageString("There is something written upon it:\r\n\r\n" + obj.Prototype.ActionDesc)
+		return nil
+	}
+
 	// Build the object description
 	var sb strings.Builder
 
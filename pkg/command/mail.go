@@ -0,0 +1,122 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/wltechblog/DikuGo/pkg/ai"
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// MailCommand represents the mail command
+type MailCommand struct{}
+
+// Execute executes the mail command
+func (c *MailCommand) Execute(character *types.Character, args string) error {
+	// A postmaster in the room takes the letter
+	if ai.HandleCommandProcs(character, "mail", args) {
+		return nil
+	}
+
+	return fmt.Errorf("Sorry, but you cannot do that here!")
+}
+
+// Name returns the name of the command
+func (c *MailCommand) Name() string {
+	return "mail"
+}
+
+// Aliases returns the aliases of the command
+func (c *MailCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *MailCommand) MinPosition() int {
+	return types.POS_STANDING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *MailCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *MailCommand) LogCommand() bool {
+	return false
+}
+
+// CheckCommand represents the check command for checking for mail
+type CheckCommand struct{}
+
+// Execute executes the check command
+func (c *CheckCommand) Execute(character *types.Character, args string) error {
+	// A postmaster in the room checks for mail
+	if ai.HandleCommandProcs(character, "check", args) {
+		return nil
+	}
+
+	return fmt.Errorf("Sorry, but you cannot do that here!")
+}
+
+// Name returns the name of the command
+func (c *CheckCommand) Name() string {
+	return "check"
+}
+
+// Aliases returns the aliases of the command
+func (c *CheckCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *CheckCommand) MinPosition() int {
+	return types.POS_STANDING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *CheckCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *CheckCommand) LogCommand() bool {
+	return false
+}
+
+// ReceiveCommand represents the receive command for collecting mail
+type ReceiveCommand struct{}
+
+// Execute executes the receive command
+func (c *ReceiveCommand) Execute(character *types.Character, args string) error {
+	// A postmaster in the room hands over the mail
+	if ai.HandleCommandProcs(character, "receive", args) {
+		return nil
+	}
+
+	return fmt.Errorf("Sorry, but you cannot do that here!")
+}
+
+// Name returns the name of the command
+func (c *ReceiveCommand) Name() string {
+	return "receive"
+}
+
+// Aliases returns the aliases of the command
+func (c *ReceiveCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *ReceiveCommand) MinPosition() int {
+	return types.POS_STANDING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *ReceiveCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *ReceiveCommand) LogCommand() bool {
+	return false
+}
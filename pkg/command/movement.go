@@ -15,9 +15,25 @@ type MovementCommand struct {
 
 // Execute executes the movement command
 func (c *MovementCommand) Execute(character *types.Character, args string) error {
+	sourceRoom := character.InRoom
+	destRoom, err := c.move(character)
+	if err != nil {
+		return err
+	}
+
+	// The character sees the new room before anyone following arrives
+	view := c.describeRoom(character, destRoom)
+	c.moveFollowers(character, sourceRoom)
+	return view
+}
+
+// move walks the character through the exit, checking the exit is open, the
+// terrain can be crossed and the character has the moves for it. It returns
+// the room the character arrived in.
+func (c *MovementCommand) move(character *types.Character) (*types.Room, error) {
 	// Check if the character is in a room
 	if character.InRoom == nil {
-		return fmt.Errorf("you are not in a room")
+		return nil, fmt.Errorf("you are not in a room")
 	}
 
 	// Check if there is an exit in the specified direction
@@ -26,14 +42,14 @@ func (c *MovementCommand) Execute(character *types.Character, args string) error
 	exit := character.InRoom.Exits[c.direction]
 	if exit == nil {
 		log.Printf("Movement: No exit in direction %d", c.direction)
-		return fmt.Errorf("you cannot go that way")
+		return nil, fmt.Errorf("you cannot go that way")
 	}
 
 	// Check if the exit leads to a room
 	log.Printf("Movement: Exit in direction %d, DestVnum: %d", c.direction, exit.DestVnum)
 	if exit.DestVnum == -1 {
 		log.Printf("Movement: Exit in direction %d has no destination room", c.direction)
-		return fmt.Errorf("you cannot go that way")
+		return nil, fmt.Errorf("you cannot go that way")
 	}
 
 	// Get the destination room from the world
@@ -42,22 +58,34 @@ func (c *MovementCommand) Execute(character *types.Character, args string) error
 		destRoom = world.GetRoom(exit.DestVnum)
 		if destRoom == nil {
 			log.Printf("Movement: Could not find destination room %d", exit.DestVnum)
-			return fmt.Errorf("you cannot go that way")
+			return nil, fmt.Errorf("you cannot go that way")
 		}
 	} else {
 		log.Printf("Movement: Character %s has no World field", character.Name)
-		return fmt.Errorf("you cannot go that way")
+		return nil, fmt.Errorf("you cannot go that way")
 	}
 
 	// Check if the exit is closed
 	if exit.IsClosed() {
 		if exit.Keywords != "" {
-			return fmt.Errorf("the %s is closed", exit.Keywords)
+			return nil, fmt.Errorf("the %s is closed", exit.Keywords)
 		} else {
-			return fmt.Errorf("the door is closed")
+			return nil, fmt.Errorf("the door is closed")
 		}
 	}
 
+	// Check the terrain can be crossed and the character has the legs for it
+	if err := types.CheckTerrain(character, destRoom); err != nil {
+		return nil, err
+	}
+	need := types.MovementCost(character.InRoom, destRoom)
+	if !character.IsNPC && character.MovePoints < need {
+		return nil, fmt.Errorf("You are too exhausted.")
+	}
+	if !character.IsNPC && character.Level < types.LEVEL_IMMORTAL {
+		character.MovePoints -= need
+	}
+
 	// Move the character to the new room using the world's proper movement method
 	if worldInterface, ok := character.World.(interface {
 		CharacterMove(*types.Character, *types.Room)
@@ -65,10 +93,17 @@ func (c *MovementCommand) Execute(character *types.Character, args string) error
 		worldInterface.CharacterMove(character, destRoom)
 	} else {
 		log.Printf("Movement: Character %s has no World interface with CharacterMove method", character.Name)
-		return fmt.Errorf("you cannot go that way")
+		return nil, fmt.Errorf("you cannot go that way")
 	}
 
-	// Show the new room to the character
+	return destRoom, nil
+}
+
+// describeRoom shows the character the room it walked into
+func (c *MovementCommand) describeRoom(character *types.Character, destRoom *types.Room) error {
+	if tooDark(character) {
+		return fmt.Errorf("It is pitch black...")
+	}
 	var sb strings.Builder
 
 	// Lock the destination room to safely read its contents
@@ -99,13 +134,16 @@ func (c *MovementCommand) Execute(character *types.Character, args string) error
 
 	// Characters in the room (safely read while holding read lock)
 	for _, ch := range destRoom.Characters {
-		if ch != character {
+		if ch != character && canSee(character, ch) {
 			sb.WriteString(fmt.Sprintf("%s is here.\r\n", ch.ShortDesc))
 		}
 	}
 
 	// Objects in the room (safely read while holding read lock)
 	for _, obj := range destRoom.Objects {
+		if !canSeeObj(character, obj) {
+			continue
+		}
 		sb.WriteString(fmt.Sprintf("%s is here.\r\n", obj.Prototype.ShortDesc))
 	}
 
@@ -113,6 +151,30 @@ func (c *MovementCommand) Execute(character *types.Character, args string) error
 	return fmt.Errorf("%s", sb.String())
 }
 
+// moveFollowers takes the followers who were standing in fromRoom along
+// after the character. Each follower goes through the exit on its own legs,
+// so one who can't follow stays behind without holding up the rest.
+func (c *MovementCommand) moveFollowers(character *types.Character, fromRoom *types.Room) {
+	world, ok := character.World.(actWorld)
+	if !ok {
+		return
+	}
+
+	followers := make([]*types.Character, len(character.Followers))
+	copy(followers, character.Followers)
+	for _, follower := range followers {
+		if follower.InRoom != fromRoom || follower.Position < types.POS_STANDING {
+			continue
+		}
+
+		world.Act("You follow $N.", false, follower, nil, character, types.TO_CHAR)
+		err := c.Execute(follower, "")
+		if err != nil {
+			follower.SendMessage(err.Error() + "\r\n")
+		}
+	}
+}
+
 // Name returns the name of the command
 func (c *MovementCommand) Name() string {
 	return directionName(c.direction)
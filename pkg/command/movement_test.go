@@ -0,0 +1,152 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// MockWorldForMovement implements the interfaces needed by movement
+type MockWorldForMovement struct {
+	rooms map[int]*types.Room
+}
+
+func (m *MockWorldForMovement) GetRoom(vnum int) *types.Room {
+	return m.rooms[vnum]
+}
+
+func (m *MockWorldForMovement) CharacterMove(ch *types.Character, room *types.Room) {
+	ch.InRoom = room
+}
+
+func (m *MockWorldForMovement) Act(msg string, hide bool, ch *types.Character, obj *types.ObjectInstance, vict *types.Character, msgType int) {
+}
+
+// newMovementTestRooms creates a room with an exit north into a room of
+// the given sector
+func newMovementTestRooms(from, to int) (*MockWorldForMovement, *types.Room, *types.Room) {
+	start := &types.Room{VNUM: 1, SectorType: from}
+	dest := &types.Room{VNUM: 2, SectorType: to}
+	start.Exits[types.DIR_NORTH] = &types.Exit{DestVnum: 2}
+	return &MockWorldForMovement{rooms: map[int]*types.Room{1: start, 2: dest}}, start, dest
+}
+
+func TestMovementCostsMovesByTerrain(t *testing.T) {
+	world, start, dest := newMovementTestRooms(types.SECT_FIELD, types.SECT_MOUNTAIN)
+	character := &types.Character{Name: "Alice", Level: 5, Position: types.POS_STANDING, InRoom: start, World: world,
+		MovePoints: 10}
+
+	(&MovementCommand{direction: types.DIR_NORTH}).Execute(character, "")
+	if character.InRoom != dest {
+		t.Fatal("Expected the character to reach the mountain")
+	}
+	if character.MovePoints != 6 {
+		t.Errorf("Expected the climb to cost 4 moves, got %d left", character.MovePoints)
+	}
+
+	character.InRoom = start
+	character.MovePoints = 3
+	err := (&MovementCommand{direction: types.DIR_NORTH}).Execute(character, "")
+	if err == nil || err.Error() != "You are too exhausted." || character.InRoom != start {
+		t.Errorf("Expected an exhausted character to stay put, got %v", err)
+	}
+}
+
+func TestMovementTerrainNeedsBoatFlightOrWaterBreathing(t *testing.T) {
+	tests := []struct {
+		sector  int
+		message string
+		needs   func(*types.Character)
+	}{
+		{types.SECT_WATER_NOSWIM, "You need a boat to go there.", func(ch *types.Character) {
+			ch.Inventory = append(ch.Inventory, &types.ObjectInstance{Prototype: &types.Object{Type: types.ITEM_BOAT}})
+		}},
+		{types.SECT_UNDERWATER, "You would need to breathe water to go there.", func(ch *types.Character) {
+			ch.AffectedBy |= types.AFF_WATERBREATH
+		}},
+		{types.SECT_FLYING, "You would need to fly to go there.", func(ch *types.Character) {
+			ch.AffectedBy |= types.AFF_FLYING
+		}},
+	}
+
+	for _, tt := range tests {
+		world, start, dest := newMovementTestRooms(types.SECT_FIELD, tt.sector)
+		character := &types.Character{Name: "Alice", Position: types.POS_STANDING, InRoom: start, World: world,
+			MovePoints: 100}
+
+		err := (&MovementCommand{direction: types.DIR_NORTH}).Execute(character, "")
+		if err == nil || err.Error() != tt.message || character.InRoom != start {
+			t.Errorf("Sector %d: expected %q, got %v", tt.sector, tt.message, err)
+		}
+
+		tt.needs(character)
+		(&MovementCommand{direction: types.DIR_NORTH}).Execute(character, "")
+		if character.InRoom != dest {
+			t.Errorf("Sector %d: expected the character to get through", tt.sector)
+		}
+	}
+}
+
+func TestFollowersWalkThroughTheSameExit(t *testing.T) {
+	world, start, dest := newMovementTestRooms(types.SECT_FIELD, types.SECT_MOUNTAIN)
+	newChar := func(name string, moves int) *types.Character {
+		return &types.Character{Name: name, Level: 5, Position: types.POS_STANDING, InRoom: start, World: world,
+			MovePoints: moves}
+	}
+	leader := newChar("Leader", 10)
+	follower := newChar("Follower", 10)
+	second := newChar("Second", 10)
+	tired := newChar("Tired", 1)
+	last := newChar("Last", 10)
+	sleeper := newChar("Sleeper", 10)
+	sleeper.Position = types.POS_SLEEPING
+
+	follow := func(ch, leader *types.Character) {
+		ch.Following = leader
+		leader.Followers = append(leader.Followers, ch)
+	}
+	follow(follower, leader)
+	follow(second, follower)
+	follow(sleeper, leader)
+	follow(tired, leader)
+	follow(last, leader)
+
+	(&MovementCommand{direction: types.DIR_NORTH}).Execute(leader, "")
+
+	// Followers of followers come along and pay for the climb themselves
+	if follower.InRoom != dest || second.InRoom != dest {
+		t.Errorf("Expected followers in the destination, got %v and %v", follower.InRoom, second.InRoom)
+	}
+	if follower.MovePoints != 6 || second.MovePoints != 6 {
+		t.Errorf("Expected followers to pay 4 moves, got %d and %d left", follower.MovePoints, second.MovePoints)
+	}
+	if sleeper.InRoom != start {
+		t.Error("Expected the sleeping follower to stay behind")
+	}
+
+	// A follower too tired for the climb stays behind, but those after
+	// still follow
+	if tired.InRoom != start {
+		t.Error("Expected the tired follower to stay behind")
+	}
+	if last.InRoom != dest {
+		t.Error("Expected the follower after the tired one to arrive")
+	}
+}
+
+func TestFollowersNeedTheirOwnWings(t *testing.T) {
+	world, start, dest := newMovementTestRooms(types.SECT_FIELD, types.SECT_FLYING)
+	leader := &types.Character{Name: "Leader", Position: types.POS_STANDING, InRoom: start, World: world,
+		MovePoints: 10, AffectedBy: types.AFF_FLYING}
+	follower := &types.Character{Name: "Follower", Position: types.POS_STANDING, InRoom: start, World: world,
+		MovePoints: 10, Following: leader}
+	leader.Followers = []*types.Character{follower}
+
+	(&MovementCommand{direction: types.DIR_NORTH}).Execute(leader, "")
+	if leader.InRoom != dest {
+		t.Fatal("Expected the flying leader to take off")
+	}
+	if follower.InRoom != start {
+		t.Error("Expected the follower who can't fly to stay behind")
+	}
+}
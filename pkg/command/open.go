@@ -224,22 +224,22 @@ func (c *OpenCommand) openContainer(character *types.Character, container *types
 	}
 
 	// Check if it's already open
-	if (container.Prototype.Value[1] & types.CONT_CLOSED) == 0 {
+	if (container.ContainerFlags() & types.CONT_CLOSED) == 0 {
 		return fmt.Errorf("but it's already open!")
 	}
 
 	// Check if it can be opened
-	if (container.Prototype.Value[1] & types.CONT_CLOSEABLE) == 0 {
+	if (container.ContainerFlags() & types.CONT_CLOSEABLE) == 0 {
 		return fmt.Errorf("you can't do that.")
 	}
 
 	// Check if it's locked
-	if (container.Prototype.Value[1] & types.CONT_LOCKED) != 0 {
+	if (container.ContainerFlags() & types.CONT_LOCKED) != 0 {
 		return fmt.Errorf("it seems to be locked.")
 	}
 
 	// Open the container by removing the CLOSED flag
-	container.Value[1] = container.Prototype.Value[1] &^ types.CONT_CLOSED
+	container.SetContainerFlags(container.ContainerFlags() &^ types.CONT_CLOSED)
 
 	// Send messages
 	character.SendMessage("Ok.\r\n")
@@ -0,0 +1,92 @@
+package command
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/wltechblog/DikuGo/pkg/storage"
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// PoseCommand represents the pose command
+type PoseCommand struct {
+	poses []*types.Pose
+	mutex sync.RWMutex
+}
+
+// Load loads the pose tiers from the poses file, replacing any loaded before
+func (c *PoseCommand) Load(filename string) (int, error) {
+	poses, err := storage.ParsePoses(filename)
+	if err != nil {
+		return 0, err
+	}
+
+	c.mutex.Lock()
+	c.poses = poses
+	c.mutex.Unlock()
+
+	return len(poses), nil
+}
+
+// Execute executes the pose command
+func (c *PoseCommand) Execute(character *types.Character, args string) error {
+	if character.IsNPC || character.Class < types.CLASS_MAGIC_USER || character.Class > types.CLASS_WARRIOR {
+		return fmt.Errorf("You can't do that.")
+	}
+
+	pose := c.findPose(character.Level)
+	if pose == nil {
+		return fmt.Errorf("You can't do that.")
+	}
+
+	world, ok := character.World.(interface {
+		Act(string, bool, *types.Character, *types.ObjectInstance, *types.Character, int)
+	})
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	world.Act(pose.CharMsg[character.Class-1], false, character, nil, nil, types.TO_CHAR)
+	world.Act(pose.RoomMsg[character.Class-1], false, character, nil, nil, types.TO_ROOM)
+
+	return nil
+}
+
+// findPose returns the highest pose tier the given level qualifies for
+func (c *PoseCommand) findPose(level int) *types.Pose {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	var best *types.Pose
+	for _, pose := range c.poses {
+		if pose.Level <= level && (best == nil || pose.Level > best.Level) {
+			best = pose
+		}
+	}
+	return best
+}
+
+// Name returns the name of the command
+func (c *PoseCommand) Name() string {
+	return "pose"
+}
+
+// Aliases returns the aliases of the command
+func (c *PoseCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *PoseCommand) MinPosition() int {
+	return types.POS_STANDING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *PoseCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *PoseCommand) LogCommand() bool {
+	return false
+}
@@ -0,0 +1,52 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+func TestPoseCommand(t *testing.T) {
+	world := &MockWorldForSocial{}
+	room := &types.Room{VNUM: 3001}
+	ch := &types.Character{
+		Name:     "Alice",
+		Class:    types.CLASS_THIEF,
+		Level:    3,
+		Position: types.POS_STANDING,
+		InRoom:   room,
+		World:    world,
+	}
+	room.Characters = []*types.Character{ch}
+
+	cmd := &PoseCommand{}
+	if _, err := cmd.Load("../../lib/poses"); err != nil {
+		t.Fatalf("Failed to load poses: %v", err)
+	}
+
+	// Below the first tier the character can't pose
+	if err := cmd.Execute(ch, ""); err == nil || err.Error() != "You can't do that." {
+		t.Errorf("Expected pose to fail below level 5, got: %v", err)
+	}
+
+	// The highest tier the level qualifies for is used
+	ch.Level = 7
+	if err := cmd.Execute(ch, ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(world.calls) != 2 {
+		t.Fatalf("Expected 2 messages, got %v", world.calls)
+	}
+	if world.calls[0].msg != "You nimbly tie yourself into a knot." || world.calls[0].msgType != types.TO_CHAR {
+		t.Errorf("Unexpected poser message: %+v", world.calls[0])
+	}
+	if world.calls[1].msg != "$n ties $mself into a knot and needs help to get untangled." || world.calls[1].msgType != types.TO_ROOM {
+		t.Errorf("Unexpected room message: %+v", world.calls[1])
+	}
+
+	// NPCs can't pose
+	npc := &types.Character{Name: "guard", IsNPC: true, Class: types.CLASS_WARRIOR, Level: 20, World: world}
+	if err := cmd.Execute(npc, ""); err == nil {
+		t.Errorf("Expected NPCs to be unable to pose")
+	}
+}
@@ -0,0 +1,314 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// actWorld is implemented by worlds that can send act messages
+type actWorld interface {
+	Act(string, bool, *types.Character, *types.ObjectInstance, *types.Character, int)
+}
+
+// StandCommand represents the stand command
+type StandCommand struct{}
+
+// Execute executes the stand command
+func (c *StandCommand) Execute(character *types.Character, args string) error {
+	world, ok := character.World.(actWorld)
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	switch character.Position {
+	case types.POS_STANDING:
+		return fmt.Errorf("You are already standing.")
+	case types.POS_SITTING:
+		character.Position = types.POS_STANDING
+		world.Act("$n clambers on $s feet.", true, character, nil, nil, types.TO_ROOM)
+		return fmt.Errorf("You stand up.")
+	case types.POS_RESTING:
+		character.Position = types.POS_STANDING
+		world.Act("$n stops resting, and clambers on $s feet.", true, character, nil, nil, types.TO_ROOM)
+		return fmt.Errorf("You stop resting, and stand up.")
+	case types.POS_SLEEPING:
+		return fmt.Errorf("You have to wake up first!")
+	case types.POS_FIGHTING:
+		return fmt.Errorf("Do you not consider fighting as standing?")
+	default:
+		character.Position = types.POS_STANDING
+		world.Act("$n stops floating around, and puts $s feet on the ground.", true, character, nil, nil, types.TO_ROOM)
+		return fmt.Errorf("You stop floating around, and put your feet on the ground.")
+	}
+}
+
+// Name returns the name of the command
+func (c *StandCommand) Name() string {
+	return "stand"
+}
+
+// Aliases returns the aliases of the command
+func (c *StandCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *StandCommand) MinPosition() int {
+	return types.POS_SLEEPING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *StandCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *StandCommand) LogCommand() bool {
+	return false
+}
+
+// SitCommand represents the sit command
+type SitCommand struct{}
+
+// Execute executes the sit command
+func (c *SitCommand) Execute(character *types.Character, args string) error {
+	world, ok := character.World.(actWorld)
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	switch character.Position {
+	case types.POS_STANDING:
+		character.Position = types.POS_SITTING
+		world.Act("$n sits down.", true, character, nil, nil, types.TO_ROOM)
+		return fmt.Errorf("You sit down.")
+	case types.POS_SITTING:
+		return fmt.Errorf("You're sitting already.")
+	case types.POS_RESTING:
+		character.Position = types.POS_SITTING
+		world.Act("$n stops resting.", true, character, nil, nil, types.TO_ROOM)
+		return fmt.Errorf("You stop resting, and sit up.")
+	case types.POS_SLEEPING:
+		return fmt.Errorf("You have to wake up first.")
+	case types.POS_FIGHTING:
+		return fmt.Errorf("Sit down while fighting? are you MAD?")
+	default:
+		character.Position = types.POS_SITTING
+		world.Act("$n stops floating around, and sits down.", true, character, nil, nil, types.TO_ROOM)
+		return fmt.Errorf("You stop floating around, and sit down.")
+	}
+}
+
+// Name returns the name of the command
+func (c *SitCommand) Name() string {
+	return "sit"
+}
+
+// Aliases returns the aliases of the command
+func (c *SitCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *SitCommand) MinPosition() int {
+	return types.POS_SLEEPING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *SitCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *SitCommand) LogCommand() bool {
+	return false
+}
+
+// RestCommand represents the rest command
+type RestCommand struct{}
+
+// Execute executes the rest command
+func (c *RestCommand) Execute(character *types.Character, args string) error {
+	world, ok := character.World.(actWorld)
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	switch character.Position {
+	case types.POS_STANDING:
+		character.Position = types.POS_RESTING
+		world.Act("$n sits down and rests.", true, character, nil, nil, types.TO_ROOM)
+		return fmt.Errorf("You sit down and rest your tired bones.")
+	case types.POS_SITTING:
+		character.Position = types.POS_RESTING
+		world.Act("$n rests.", true, character, nil, nil, types.TO_ROOM)
+		return fmt.Errorf("You rest your tired bones.")
+	case types.POS_RESTING:
+		return fmt.Errorf("You are already resting.")
+	case types.POS_SLEEPING:
+		return fmt.Errorf("You have to wake up first.")
+	case types.POS_FIGHTING:
+		return fmt.Errorf("Rest while fighting? are you MAD?")
+	default:
+		character.Position = types.POS_RESTING
+		world.Act("$n stops floating around, and rests.", true, character, nil, nil, types.TO_ROOM)
+		return fmt.Errorf("You stop floating around, and stop to rest your tired bones.")
+	}
+}
+
+// Name returns the name of the command
+func (c *RestCommand) Name() string {
+	return "rest"
+}
+
+// Aliases returns the aliases of the command
+func (c *RestCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *RestCommand) MinPosition() int {
+	return types.POS_SLEEPING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *RestCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *RestCommand) LogCommand() bool {
+	return false
+}
+
+// SleepCommand represents the sleep command
+type SleepCommand struct{}
+
+// Execute executes the sleep command
+func (c *SleepCommand) Execute(character *types.Character, args string) error {
+	world, ok := character.World.(actWorld)
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	switch character.Position {
+	case types.POS_STANDING, types.POS_SITTING, types.POS_RESTING:
+		character.Position = types.POS_SLEEPING
+		world.Act("$n lies down and falls asleep.", true, character, nil, nil, types.TO_ROOM)
+		return fmt.Errorf("You go to sleep.")
+	case types.POS_SLEEPING:
+		return fmt.Errorf("You are already sound asleep.")
+	case types.POS_FIGHTING:
+		return fmt.Errorf("Sleep while fighting? are you MAD?")
+	default:
+		character.Position = types.POS_SLEEPING
+		world.Act("$n stops floating around, and lies down to sleep.", true, character, nil, nil, types.TO_ROOM)
+		return fmt.Errorf("You stop floating around, and lie down to sleep.")
+	}
+}
+
+// Name returns the name of the command
+func (c *SleepCommand) Name() string {
+	return "sleep"
+}
+
+// Aliases returns the aliases of the command
+func (c *SleepCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *SleepCommand) MinPosition() int {
+	return types.POS_SLEEPING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *SleepCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *SleepCommand) LogCommand() bool {
+	return false
+}
+
+// WakeCommand wakes the character or someone else up
+type WakeCommand struct{}
+
+// Execute executes the wake command
+func (c *WakeCommand) Execute(character *types.Character, args string) error {
+	world, ok := character.World.(actWorld)
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	name := strings.TrimSpace(args)
+	if name == "" {
+		switch {
+		case character.AffectedBy&types.AFF_SLEEP != 0:
+			return fmt.Errorf("You can't wake up!")
+		case character.Position > types.POS_SLEEPING:
+			return fmt.Errorf("You are already awake...")
+		default:
+			character.Position = types.POS_SITTING
+			world.Act("$n awakens.", true, character, nil, nil, types.TO_ROOM)
+			return fmt.Errorf("You wake, and sit up.")
+		}
+	}
+
+	if character.Position == types.POS_SLEEPING {
+		return fmt.Errorf("You can't wake people up if you are asleep yourself!")
+	}
+
+	if character.InRoom == nil {
+		return fmt.Errorf("you are not in a room")
+	}
+
+	character.InRoom.RLock()
+	target := findCharacterInRoom(character, name)
+	character.InRoom.RUnlock()
+
+	switch {
+	case target == nil:
+		return fmt.Errorf("You do not see that person here.")
+	case target == character:
+		return fmt.Errorf("If you want to wake yourself up, just type 'wake'")
+	case target.Position != types.POS_SLEEPING:
+		world.Act("$N is already awake.", false, character, nil, target, types.TO_CHAR)
+	case target.AffectedBy&types.AFF_SLEEP != 0:
+		world.Act("You can not wake $M up!", false, character, nil, target, types.TO_CHAR)
+	default:
+		target.Position = types.POS_SITTING
+		world.Act("You wake $M up.", false, character, nil, target, types.TO_CHAR)
+		world.Act("You are awakened by $n.", false, character, nil, target, types.TO_VICT)
+	}
+
+	return nil
+}
+
+// Name returns the name of the command
+func (c *WakeCommand) Name() string {
+	return "wake"
+}
+
+// Aliases returns the aliases of the command
+func (c *WakeCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *WakeCommand) MinPosition() int {
+	return types.POS_SLEEPING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *WakeCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *WakeCommand) LogCommand() bool {
+	return false
+}
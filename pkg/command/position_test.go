@@ -0,0 +1,67 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+func TestPositionTransitions(t *testing.T) {
+	world := &MockWorldForSocial{}
+	ch := &types.Character{Name: "Alice", Position: types.POS_STANDING, World: world}
+
+	steps := []struct {
+		cmd      Command
+		expected string
+		position int
+	}{
+		{&RestCommand{}, "You sit down and rest your tired bones.", types.POS_RESTING},
+		{&SitCommand{}, "You stop resting, and sit up.", types.POS_SITTING},
+		{&SleepCommand{}, "You go to sleep.", types.POS_SLEEPING},
+		{&StandCommand{}, "You have to wake up first!", types.POS_SLEEPING},
+		{&LookCommand{}, "You can't see anything, you're sleeping!", types.POS_SLEEPING},
+		{&WakeCommand{}, "You wake, and sit up.", types.POS_SITTING},
+		{&StandCommand{}, "You stand up.", types.POS_STANDING},
+	}
+
+	for _, step := range steps {
+		err := step.cmd.Execute(ch, "")
+		if err == nil || err.Error() != step.expected {
+			t.Errorf("%s: expected %q, got %v", step.cmd.Name(), step.expected, err)
+		}
+		if ch.Position != step.position {
+			t.Errorf("%s: expected position %d, got %d", step.cmd.Name(), step.position, ch.Position)
+		}
+	}
+
+	// No sleeping in the middle of a fight
+	ch.Position = types.POS_FIGHTING
+	if err := (&SleepCommand{}).Execute(ch, ""); err == nil || err.Error() != "Sleep while fighting? are you MAD?" {
+		t.Errorf("Expected to refuse sleeping while fighting, got %v", err)
+	}
+}
+
+func TestWakeOther(t *testing.T) {
+	world := &MockWorldForSocial{}
+	room := &types.Room{VNUM: 3001}
+	actor := &types.Character{Name: "Alice", Position: types.POS_STANDING, InRoom: room, World: world}
+	sleeper := &types.Character{Name: "Bob", Position: types.POS_SLEEPING, InRoom: room, World: world}
+	spelled := &types.Character{Name: "Carol", Position: types.POS_SLEEPING, InRoom: room, World: world, AffectedBy: types.AFF_SLEEP}
+	room.Characters = []*types.Character{actor, sleeper, spelled}
+
+	cmd := &WakeCommand{}
+	cmd.Execute(actor, "bob")
+	if sleeper.Position != types.POS_SITTING {
+		t.Errorf("Expected Bob to be woken up, got position %d", sleeper.Position)
+	}
+
+	cmd.Execute(actor, "carol")
+	if spelled.Position != types.POS_SLEEPING {
+		t.Error("Expected magical sleep to keep Carol asleep")
+	}
+
+	actor.Position = types.POS_SLEEPING
+	if err := cmd.Execute(actor, "bob"); err == nil || err.Error() != "You can't wake people up if you are asleep yourself!" {
+		t.Errorf("Expected sleeping actor to be refused, got %v", err)
+	}
+}
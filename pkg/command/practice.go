@@ -0,0 +1,46 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/wltechblog/DikuGo/pkg/ai"
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// PracticeCommand represents the practice command
+type PracticeCommand struct{}
+
+// Execute executes the practice command
+func (c *PracticeCommand) Execute(character *types.Character, args string) error {
+	// A guildmaster in the room handles the actual practicing
+	if ai.HandleCommandProcs(character, "practice", args) {
+		return nil
+	}
+
+	return fmt.Errorf("You have %d practice sessions left.\r\nYou can only practice in a guild.", character.Practices)
+}
+
+// Name returns the name of the command
+func (c *PracticeCommand) Name() string {
+	return "practice"
+}
+
+// Aliases returns the aliases of the command
+func (c *PracticeCommand) Aliases() []string {
+	return []string{"practise", "prac"}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *PracticeCommand) MinPosition() int {
+	return types.POS_RESTING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *PracticeCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *PracticeCommand) LogCommand() bool {
+	return false
+}
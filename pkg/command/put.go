@@ -70,7 +70,7 @@ func (c *PutCommand) Execute(character *types.Character, args string) error {
 	// Find the container
 	containerObj := findObjectInInventory(character, container)
 	if containerObj == nil {
-		containerObj = findObjectInRoom(character.InRoom, container)
+		containerObj = findObjectInRoom(character, container)
 	}
 	if containerObj == nil {
 		return fmt.Errorf("you don't see %s here", container)
@@ -82,7 +82,7 @@ func (c *PutCommand) Execute(character *types.Character, args string) error {
 	}
 
 	// Check if the container is closed
-	if containerObj.Prototype.Value[1]&types.CONT_CLOSED != 0 {
+	if containerObj.ContainerFlags()&types.CONT_CLOSED != 0 {
 		return fmt.Errorf("%s is closed", containerObj.Prototype.ShortDesc)
 	}
 
@@ -144,7 +144,7 @@ func (c *PutCommand) putAll(character *types.Character, container string) error
 	// Find the container
 	containerObj := findObjectInInventory(character, container)
 	if containerObj == nil {
-		containerObj = findObjectInRoom(character.InRoom, container)
+		containerObj = findObjectInRoom(character, container)
 	}
 	if containerObj == nil {
 		return fmt.Errorf("you don't see %s here", container)
@@ -156,7 +156,7 @@ func (c *PutCommand) putAll(character *types.Character, container string) error
 	}
 
 	// Check if the container is closed
-	if containerObj.Prototype.Value[1]&types.CONT_CLOSED != 0 {
+	if containerObj.ContainerFlags()&types.CONT_CLOSED != 0 {
 		return fmt.Errorf("%s is closed", containerObj.Prototype.ShortDesc)
 	}
 
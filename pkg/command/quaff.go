@@ -55,7 +55,7 @@ func DoQuaff(character *types.Character, arguments string, world *world.World) e
 		spellID := potion.Prototype.Value[i]
 		if spellID > 0 && spellID < types.MAX_SPELLS {
 			level := potion.Prototype.Value[0]
-			castSpell(world, spellID, level, character, "", types.SPELL_TYPE_POTION, character, nil)
+			world.CastSpell(spellID, level, character, "", types.SPELL_TYPE_POTION, character, nil)
 		}
 	}
 
@@ -16,6 +16,14 @@ func (c *QuitCommand) Execute(character *types.Character, args string) error {
 		return fmt.Errorf("no way! you're fighting for your life!")
 	}
 
+	// Only what is left with a receptionist is kept, everything else
+	// stays behind
+	if world, ok := character.World.(interface {
+		DropAllObjects(*types.Character)
+	}); ok {
+		world.DropAllObjects(character)
+	}
+
 	// Send a message to the character
 	// TODO: Implement a way to send messages to characters
 	// For now, just return a message as an error
@@ -25,7 +25,7 @@ func DoRecite(character *types.Character, arguments string, world *world.World)
 
 	// Find the scroll in inventory or held
 	var scroll *types.ObjectInstance
-	
+
 	// Check if holding a scroll
 	if character.Equipment[types.WEAR_HOLD] != nil {
 		obj := character.Equipment[types.WEAR_HOLD]
@@ -53,59 +53,32 @@ func DoRecite(character *types.Character, arguments string, world *world.World)
 		return fmt.Errorf("you don't have that scroll")
 	}
 
-	// Find the target
-	var victim *types.Character
-	var obj *types.ObjectInstance
-
-	if targetArg != "" {
-		// Try to find a character target
-		victim = world.GetCharacterInRoom(character.InRoom, targetArg)
-		
-		// If no character found, try to find an object target
-		if victim == nil {
-			// Check inventory
-			for _, o := range character.Inventory {
-				if strings.Contains(strings.ToLower(o.Prototype.Name), targetArg) {
-					obj = o
-					break
-				}
-			}
-			
-			// Check room
-			if obj == nil {
-				for _, o := range character.InRoom.Objects {
-					if strings.Contains(strings.ToLower(o.Prototype.Name), targetArg) {
-						obj = o
-						break
-					}
-				}
-			}
-			
-			// Check equipment
-			if obj == nil {
-				for _, o := range character.Equipment {
-					if o != nil && strings.Contains(strings.ToLower(o.Prototype.Name), targetArg) {
-						obj = o
-						break
-					}
-				}
-			}
+	// Each spell on the scroll finds its target the way it would if it
+	// were cast, and the scroll is kept if any of them can't
+	level := scroll.Prototype.Value[0]
+	var victims [4]*types.Character
+	var objs [4]*types.ObjectInstance
+	for i := 1; i <= 3; i++ {
+		spellID := scroll.Prototype.Value[i]
+		if spellID <= 0 || spellID >= types.MAX_SPELLS {
+			continue
+		}
+		victim, obj, err := world.GetSpellTarget(character, targetArg, spellID)
+		if err != nil {
+			return err
 		}
-	} else {
-		// If no target specified, target self
-		victim = character
+		victims[i], objs[i] = victim, obj
 	}
 
 	// Send messages
 	world.Act("$n recites $p.", true, character, scroll, nil, types.TO_ROOM)
 	world.Act("You recite $p which dissolves.", false, character, scroll, nil, types.TO_CHAR)
 
-	// Cast the spells in the scroll
+	// Cast the spells in the scroll at the scroll's level
 	for i := 1; i <= 3; i++ {
 		spellID := scroll.Prototype.Value[i]
 		if spellID > 0 && spellID < types.MAX_SPELLS {
-			level := scroll.Prototype.Value[0]
-			castSpell(world, spellID, level, character, "", types.SPELL_TYPE_SCROLL, victim, obj)
+			world.CastSpell(spellID, level, character, "", types.SPELL_TYPE_SCROLL, victims[i], objs[i])
 		}
 	}
 
@@ -0,0 +1,65 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+	"github.com/wltechblog/DikuGo/pkg/world"
+)
+
+func TestReciteAndWandTargets(t *testing.T) {
+	w, err := world.NewWorld(nil, world.NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	room := &types.Room{VNUM: 3001, Flags: types.ROOM_INDOORS}
+	newChar := func(name string, npc bool) *types.Character {
+		return &types.Character{Name: name, ShortDesc: name, Level: 10, HP: 500, MaxHitPoints: 500, IsNPC: npc,
+			Position: types.POS_STANDING, World: w, Equipment: make([]*types.ObjectInstance, types.NUM_WEARS)}
+	}
+	reader := newChar("Alice", false)
+	bob := newChar("Bob", false)
+	ogre := newChar("ogre", true)
+	w.CharToRoom(reader, room)
+	w.CharToRoom(bob, room)
+	w.CharToRoom(ogre, room)
+
+	scroll := &types.ObjectInstance{Prototype: &types.Object{VNUM: 3050, Name: "scroll", ShortDesc: "a scroll",
+		Type: types.ITEM_SCROLL, Value: [4]int{12, types.SPELL_MAGIC_MISSILE, -1, -1}}, CarriedBy: reader}
+	reader.Inventory = []*types.ObjectInstance{scroll}
+
+	// Another player can't be the victim, and the scroll is kept
+	if err := DoRecite(reader, "scroll bob", w); err == nil {
+		t.Error("Expected reciting magic missile at another player to be refused")
+	}
+	if len(reader.Inventory) != 1 || bob.HP != 500 {
+		t.Error("Expected the scroll to be kept and Bob left alone")
+	}
+
+	// With no target and no fight a violent scroll has nothing to go for
+	if err := DoRecite(reader, "scroll", w); err == nil {
+		t.Error("Expected reciting magic missile at nobody to be refused")
+	}
+
+	if err := DoRecite(reader, "scroll ogre", w); err != nil {
+		t.Fatalf("Expected reciting magic missile at the ogre to succeed, got %v", err)
+	}
+	if len(reader.Inventory) != 0 {
+		t.Error("Expected the scroll to dissolve")
+	}
+	if reader.Fighting != ogre || ogre.Fighting != reader {
+		t.Error("Expected the ogre to fight back")
+	}
+
+	// A wand of a violent spell keeps its charge when pointed at a player
+	wand := &types.ObjectInstance{Prototype: &types.Object{VNUM: 3051, Name: "wand", ShortDesc: "a wand",
+		Type: types.ITEM_WAND, Value: [4]int{12, 5, 5, types.SPELL_MAGIC_MISSILE}}, WornBy: reader, WornOn: types.WEAR_HOLD}
+	reader.Equipment[types.WEAR_HOLD] = wand
+	if err := DoUse(reader, "wand bob", w); err == nil {
+		t.Error("Expected pointing the wand at another player to be refused")
+	}
+	if wand.Prototype.Value[2] != 5 {
+		t.Errorf("Expected the wand to keep its charges, got %d", wand.Prototype.Value[2])
+	}
+}
@@ -1,6 +1,9 @@
 package command
 
 import (
+	"log"
+	"path/filepath"
+
 	"github.com/wltechblog/DikuGo/pkg/types"
 	"github.com/wltechblog/DikuGo/pkg/world"
 )
@@ -12,12 +15,30 @@ func InitRegistry(w *world.World, combatManager CombatManagerInterface) *Registr
 	// Register commands
 	registry.Register(&LookCommand{})
 	registry.Register(&SayCommand{})
+	registry.Register(&TellCommand{})
+	registry.Register(&ReplyCommand{})
+	registry.Register(&WhisperCommand{})
+	registry.Register(&AskCommand{})
+	registry.Register(&ShoutCommand{})
+	registry.Register(&YellCommand{})
+	registry.Register(&DeafCommand{})
+	registry.Register(&NoTellCommand{})
 	registry.Register(&WhoCommand{})
 	registry.Register(&QuitCommand{})
 	registry.Register(&KillCommand{CombatManager: combatManager})
+	registry.Register(&FleeCommand{CombatManager: combatManager})
+	registry.Register(&WimpyCommand{})
+	registry.Register(&StandCommand{})
+	registry.Register(&SitCommand{})
+	registry.Register(&RestCommand{})
+	registry.Register(&SleepCommand{})
+	registry.Register(&WakeCommand{})
 	registry.Register(&GetCommand{})
 	registry.Register(&DropCommand{})
 	registry.Register(&PutCommand{})
+	registry.Register(&GiveCommand{})
+	registry.Register(&ReadCommand{})
+	registry.Register(&WriteCommand{})
 	registry.Register(&ExamineCommand{})
 	registry.Register(&InventoryCommand{})
 	registry.Register(&WearCommand{})
@@ -28,7 +49,19 @@ func InitRegistry(w *world.World, combatManager CombatManagerInterface) *Registr
 	registry.Register(&ListCommand{})
 	registry.Register(&BuyCommand{})
 	registry.Register(&SellCommand{})
+	registry.Register(&RentCommand{})
+	registry.Register(&OfferCommand{})
+	registry.Register(&MailCommand{})
+	registry.Register(&CheckCommand{})
+	registry.Register(&ReceiveCommand{})
 	registry.Register(&ScoreCommand{})
+	registry.Register(&LevelsCommand{})
+	registry.Register(&PracticeCommand{})
+	registry.Register(&FollowCommand{})
+	registry.Register(&UnfollowCommand{})
+	registry.Register(&GroupCommand{})
+	registry.Register(&UngroupCommand{})
+	registry.Register(&GtellCommand{})
 	registry.Register(&PromptCommand{})
 	registry.Register(&TimeCommand{})
 
@@ -41,11 +74,15 @@ func InitRegistry(w *world.World, combatManager CombatManagerInterface) *Registr
 	registry.Register(&TasteCommand{})
 	registry.Register(&OpenCommand{})
 	registry.Register(&CloseCommand{})
+	registry.Register(&LockCommand{})
+	registry.Register(&UnlockCommand{})
+	registry.Register(&PickCommand{})
 	registry.Register(&ReciteCommand{World: w})
 	registry.Register(&UseCommand{World: w})
 
 	// Register admin commands
 	registry.Register(&GotoCommand{})
+	registry.Register(&HolylightCommand{})
 	registry.Register(&RstatCommand{})
 	registry.Register(&MobstatCommand{})
 	registry.Register(&ShopstatCommand{})
@@ -59,9 +96,12 @@ func InitRegistry(w *world.World, combatManager CombatManagerInterface) *Registr
 
 	// Register combat skill commands
 	registry.Register(&BashCommand{CombatManager: combatManager})
+	registry.Register(&KickCommand{CombatManager: combatManager})
 	registry.Register(&RescueCommand{CombatManager: combatManager})
 	registry.Register(&BackstabCommand{CombatManager: combatManager})
 	registry.Register(&StealCommand{CombatManager: combatManager})
+	registry.Register(&HideCommand{})
+	registry.Register(&SneakCommand{})
 	registry.Register(&CreateBakerCommand{})
 	registry.Register(&AddBakerCommand{})
 	registry.Register(&ValidateRoomsCommand{})
@@ -76,9 +116,37 @@ func InitRegistry(w *world.World, combatManager CombatManagerInterface) *Registr
 	registry.Register(&MovementCommand{direction: types.DIR_UP})
 	registry.Register(&MovementCommand{direction: types.DIR_DOWN})
 
+	// Register the static text file commands (news, credits, ...)
+	for _, file := range world.TextFileNames {
+		registry.Register(&TextFileCommand{file: file})
+	}
+
 	// Register help command (needs registry)
 	helpCmd := &HelpCommand{Registry: registry}
+	if count, err := helpCmd.Load(filepath.Join(w.DataPath(), "help_table"), filepath.Join(w.DataPath(), "help")); err != nil {
+		log.Printf("Warning: failed to load help: %v", err)
+	} else {
+		log.Printf("Loaded %d help entries", count)
+	}
 	registry.Register(helpCmd)
 
+	// Register pose command (loads lib/poses)
+	poseCmd := &PoseCommand{}
+	if count, err := poseCmd.Load(filepath.Join(w.DataPath(), "poses")); err != nil {
+		log.Printf("Warning: failed to load poses: %v", err)
+	} else {
+		log.Printf("Loaded %d pose tiers", count)
+	}
+	registry.Register(poseCmd)
+
+	// Register socials last so they never shadow a regular command
+	registry.Register(&SocialsCommand{Registry: registry})
+	registry.Register(&ReloadCommand{Registry: registry, DataPath: w.DataPath()})
+	if count, err := LoadSocials(registry, filepath.Join(w.DataPath(), "actions")); err != nil {
+		log.Printf("Warning: failed to load socials: %v", err)
+	} else {
+		log.Printf("Loaded %d socials", count)
+	}
+
 	return registry
 }
@@ -0,0 +1,101 @@
+package command
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// ReloadCommand reloads game data from the lib directory without a restart
+type ReloadCommand struct {
+	// Registry is the command registry
+	Registry *Registry
+
+	// DataPath is the directory holding the lib files
+	DataPath string
+}
+
+// Execute executes the reload command
+func (c *ReloadCommand) Execute(character *types.Character, args string) error {
+	switch strings.ToLower(strings.TrimSpace(args)) {
+	case "socials":
+		count, err := LoadSocials(c.Registry, filepath.Join(c.DataPath, "actions"))
+		if err != nil {
+			return fmt.Errorf("Failed to reload socials: %v", err)
+		}
+		return fmt.Errorf("Reloaded %d socials.", count)
+	case "poses":
+		poseCmd, ok := c.Registry.Find("pose").(*PoseCommand)
+		if !ok {
+			return fmt.Errorf("The pose command is not available.")
+		}
+		count, err := poseCmd.Load(filepath.Join(c.DataPath, "poses"))
+		if err != nil {
+			return fmt.Errorf("Failed to reload poses: %v", err)
+		}
+		return fmt.Errorf("Reloaded %d pose tiers.", count)
+	case "help":
+		helpCmd, ok := c.Registry.Find("help").(*HelpCommand)
+		if !ok {
+			return fmt.Errorf("The help command is not available.")
+		}
+		count, err := helpCmd.Load(filepath.Join(c.DataPath, "help_table"), filepath.Join(c.DataPath, "help"))
+		if err != nil {
+			return fmt.Errorf("Failed to reload help: %v", err)
+		}
+		return fmt.Errorf("Reloaded %d help entries.", count)
+	case "text":
+		world, ok := character.World.(interface {
+			LoadTextFiles() (int, error)
+		})
+		if !ok {
+			return fmt.Errorf("world interface not available")
+		}
+		count, err := world.LoadTextFiles()
+		if err != nil {
+			return fmt.Errorf("Failed to reload text files: %v", err)
+		}
+		return fmt.Errorf("Reloaded %d text files.", count)
+	case "messages":
+		world, ok := character.World.(interface {
+			LoadMessages() (int, error)
+		})
+		if !ok {
+			return fmt.Errorf("world interface not available")
+		}
+		count, err := world.LoadMessages()
+		if err != nil {
+			return fmt.Errorf("Failed to reload combat messages: %v", err)
+		}
+		return fmt.Errorf("Reloaded %d combat messages.", count)
+	default:
+		return fmt.Errorf("Reload what? (socials, poses, help, text, messages)")
+	}
+}
+
+// Name returns the name of the command
+func (c *ReloadCommand) Name() string {
+	return "reload"
+}
+
+// Aliases returns the aliases of the command
+func (c *ReloadCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *ReloadCommand) MinPosition() int {
+	return types.POS_DEAD
+}
+
+// Level returns the minimum level required to execute the command
+func (c *ReloadCommand) Level() int {
+	return types.LEVEL_IMMORTAL
+}
+
+// LogCommand returns whether the command should be logged
+func (c *ReloadCommand) LogCommand() bool {
+	return true
+}
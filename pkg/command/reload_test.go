@@ -0,0 +1,23 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+func TestReloadIsForImmortals(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(&ReloadCommand{Registry: registry, DataPath: "../../lib"})
+	registry.Register(&PoseCommand{})
+
+	mortal := &types.Character{Name: "Alice", Level: types.LEVEL_IMMORTAL - 1, Position: types.POS_STANDING}
+	if err := registry.Execute(mortal, "reload poses"); err != ErrInsufficientLevel {
+		t.Errorf("Expected a mortal to be refused, got %v", err)
+	}
+
+	immortal := &types.Character{Name: "Bob", Level: types.LEVEL_IMMORTAL, Position: types.POS_STANDING}
+	if err := registry.Execute(immortal, "reload poses"); err == nil || err == ErrInsufficientLevel {
+		t.Errorf("Expected an immortal to reload, got %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/wltechblog/DikuGo/pkg/types"
@@ -17,6 +18,15 @@ func (c *RemoveCommand) Execute(character *types.Character, args string) error {
 		return fmt.Errorf("remove what?")
 	}
 
+	// "remove <number>" removes a message from a bulletin board
+	if n, err := strconv.Atoi(strings.TrimSpace(args)); err == nil {
+		if world, ok := character.World.(boardWorld); ok {
+			if board := findBoard(world, character); board != nil {
+				return removeBoardMessage(world, character, board, n)
+			}
+		}
+	}
+
 	// Check if the target is "all"
 	if strings.ToLower(args) == "all" {
 		return c.removeAll(character)
@@ -57,6 +67,10 @@ func (c *RemoveCommand) Execute(character *types.Character, args string) error {
 	obj.CarriedBy = character
 	character.Inventory = append(character.Inventory, obj)
 
+	if position == types.WEAR_LIGHT {
+		updateRoomLight(character)
+	}
+
 	// Send a message to the character
 	return fmt.Errorf("you remove %s.\r\n", obj.Prototype.ShortDesc)
 }
@@ -107,6 +121,8 @@ func (c *RemoveCommand) removeAll(character *types.Character) error {
 		sb.WriteString(fmt.Sprintf("You remove %s.\r\n", obj.Prototype.ShortDesc))
 	}
 
+	updateRoomLight(character)
+
 	// Send the messages to the character
 	return fmt.Errorf("%s", sb.String())
 }
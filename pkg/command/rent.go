@@ -0,0 +1,84 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/wltechblog/DikuGo/pkg/ai"
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// RentCommand represents the rent command
+type RentCommand struct{}
+
+// Execute executes the rent command
+func (c *RentCommand) Execute(character *types.Character, args string) error {
+	// A receptionist in the room stores the character's objects
+	if ai.HandleCommandProcs(character, "rent", args) {
+		return nil
+	}
+
+	return fmt.Errorf("Sorry, but you cannot do that here!")
+}
+
+// Name returns the name of the command
+func (c *RentCommand) Name() string {
+	return "rent"
+}
+
+// Aliases returns the aliases of the command
+func (c *RentCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *RentCommand) MinPosition() int {
+	return types.POS_STANDING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *RentCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *RentCommand) LogCommand() bool {
+	return false
+}
+
+// OfferCommand represents the offer command
+type OfferCommand struct{}
+
+// Execute executes the offer command
+func (c *OfferCommand) Execute(character *types.Character, args string) error {
+	// A receptionist in the room quotes the rent
+	if ai.HandleCommandProcs(character, "offer", args) {
+		return nil
+	}
+
+	return fmt.Errorf("Sorry, but you cannot do that here!")
+}
+
+// Name returns the name of the command
+func (c *OfferCommand) Name() string {
+	return "offer"
+}
+
+// Aliases returns the aliases of the command
+func (c *OfferCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *OfferCommand) MinPosition() int {
+	return types.POS_STANDING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *OfferCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *OfferCommand) LogCommand() bool {
+	return false
+}
@@ -42,7 +42,7 @@ func (c *RescueCommand) Execute(character *types.Character, args string) error {
 	}
 
 	// Find the target in the room
-	victim := findCharacterInRoom(character.InRoom, args)
+	victim := findCharacterInRoom(character, args)
 	if victim == nil {
 		return fmt.Errorf("they aren't here")
 	}
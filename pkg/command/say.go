@@ -24,21 +24,21 @@ func (c *SayCommand) Execute(character *types.Character, args string) error {
 	// Get the room
 	room := character.InRoom
 
-	// Send the message to the character
-	// TODO: Implement a way to send messages to characters
-	// For now, just return the message as an error
-	message := fmt.Sprintf("You say, '%s'\r\n", args)
-
-	// Send the message to everyone else in the room
+	// Send the message to everyone else in the room who is awake
+	room.RLock()
+	listeners := make([]*types.Character, 0, len(room.Characters))
 	for _, ch := range room.Characters {
-		if ch != character {
-			// TODO: Implement a way to send messages to characters
-			// For now, just ignore this
-			_ = fmt.Sprintf("%s says, '%s'\r\n", character.Name, args)
+		if ch != character && ch.Position > types.POS_SLEEPING {
+			listeners = append(listeners, ch)
 		}
 	}
+	room.RUnlock()
+
+	for _, ch := range listeners {
+		ch.SendMessage(fmt.Sprintf("%s says, '%s'\r\n", getSpeakerName(character), args))
+	}
 
-	return fmt.Errorf("%s", message)
+	return fmt.Errorf("You say, '%s'", args)
 }
 
 // Name returns the name of the command
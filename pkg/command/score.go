@@ -81,7 +81,7 @@ func Score(ch *types.Character, args string) bool {
 	sb.WriteString(fmt.Sprintf("Gold: %d  Experience: %d\r\n", ch.Gold, ch.Experience))
 
 	// Calculate experience needed for next level
-	expForNextLevel := getExpForLevel(ch.Level + 1)
+	expForNextLevel := types.GetExpForLevel(ch.Class, ch.Level+1)
 	expNeeded := expForNextLevel - ch.Experience
 	if expNeeded < 0 {
 		expNeeded = 0
@@ -156,19 +156,3 @@ func getAlignmentString(alignment int) string {
 		return "Evil"
 	}
 }
-
-// getExpForLevel returns the experience needed for a given level
-func getExpForLevel(level int) int {
-	// Simple exponential experience curve
-	// Level 1: 0 exp
-	// Level 2: 1000 exp
-	// Level 3: 3000 exp
-	// Level 4: 6000 exp
-	// Level 5: 10000 exp
-	// etc.
-	if level <= 1 {
-		return 0
-	}
-
-	return 1000 * (level - 1) * level / 2
-}
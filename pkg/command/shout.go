@@ -0,0 +1,235 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// ShoutCommand represents the shout command, which is heard by everyone in the game
+type ShoutCommand struct{}
+
+// Execute executes the shout command
+func (c *ShoutCommand) Execute(character *types.Character, args string) error {
+	args = strings.TrimSpace(args)
+	if args == "" {
+		return fmt.Errorf("Shout? Yes! Fine! Shout we must, but WHAT??")
+	}
+
+	if !character.IsNPC && character.Flags&types.PLR_DEAF != 0 {
+		return fmt.Errorf("You can't shout while you are deaf!")
+	}
+
+	world, ok := character.World.(interface {
+		GetCharacters() map[string]*types.Character
+		SendMessageToCharacter(*types.Character, string)
+	})
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	message := fmt.Sprintf("%s shouts '%s'\r\n", getSpeakerName(character), args)
+	for _, ch := range world.GetCharacters() {
+		if canHearShout(character, ch) {
+			world.SendMessageToCharacter(ch, message)
+		}
+	}
+
+	return fmt.Errorf("You shout '%s'", args)
+}
+
+// Name returns the name of the command
+func (c *ShoutCommand) Name() string {
+	return "shout"
+}
+
+// Aliases returns the aliases of the command
+func (c *ShoutCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *ShoutCommand) MinPosition() int {
+	return types.POS_RESTING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *ShoutCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *ShoutCommand) LogCommand() bool {
+	return false
+}
+
+// YellCommand represents the yell command, which is heard throughout the current zone
+type YellCommand struct{}
+
+// Execute executes the yell command
+func (c *YellCommand) Execute(character *types.Character, args string) error {
+	args = strings.TrimSpace(args)
+	if args == "" {
+		return fmt.Errorf("Yell what?")
+	}
+
+	if !character.IsNPC && character.Flags&types.PLR_DEAF != 0 {
+		return fmt.Errorf("You can't yell while you are deaf!")
+	}
+
+	if character.InRoom == nil {
+		return fmt.Errorf("you are not in a room")
+	}
+
+	world, ok := character.World.(interface {
+		GetCharacters() map[string]*types.Character
+		GetZoneForRoom(int) *types.Zone
+		SendMessageToCharacter(*types.Character, string)
+	})
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	zone := world.GetZoneForRoom(character.InRoom.VNUM)
+
+	message := fmt.Sprintf("%s yells '%s'\r\n", getSpeakerName(character), args)
+	for _, ch := range world.GetCharacters() {
+		if !canHearShout(character, ch) || ch.InRoom == nil {
+			continue
+		}
+
+		// Without a zone, a yell only carries as far as the room
+		if zone == nil {
+			if ch.InRoom != character.InRoom {
+				continue
+			}
+		} else if world.GetZoneForRoom(ch.InRoom.VNUM) != zone {
+			continue
+		}
+
+		world.SendMessageToCharacter(ch, message)
+	}
+
+	return fmt.Errorf("You yell '%s'", args)
+}
+
+// Name returns the name of the command
+func (c *YellCommand) Name() string {
+	return "yell"
+}
+
+// Aliases returns the aliases of the command
+func (c *YellCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *YellCommand) MinPosition() int {
+	return types.POS_RESTING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *YellCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *YellCommand) LogCommand() bool {
+	return false
+}
+
+// canHearShout checks if a listener can hear a shout or yell from the speaker
+func canHearShout(speaker, listener *types.Character) bool {
+	if listener == speaker || listener.IsNPC {
+		return false
+	}
+
+	if listener.Position <= types.POS_SLEEPING {
+		return false
+	}
+
+	return listener.Flags&types.PLR_DEAF == 0
+}
+
+// DeafCommand toggles whether the character hears shouts and yells
+type DeafCommand struct{}
+
+// Execute executes the deaf command
+func (c *DeafCommand) Execute(character *types.Character, args string) error {
+	if character.IsNPC {
+		return fmt.Errorf("You don't need to do that.")
+	}
+
+	character.Flags ^= types.PLR_DEAF
+	if character.Flags&types.PLR_DEAF != 0 {
+		return fmt.Errorf("You are now deaf to shouts and yells.")
+	}
+	return fmt.Errorf("You can now hear shouts and yells again.")
+}
+
+// Name returns the name of the command
+func (c *DeafCommand) Name() string {
+	return "deaf"
+}
+
+// Aliases returns the aliases of the command
+func (c *DeafCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *DeafCommand) MinPosition() int {
+	return types.POS_DEAD
+}
+
+// Level returns the minimum level required to execute the command
+func (c *DeafCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *DeafCommand) LogCommand() bool {
+	return false
+}
+
+// NoTellCommand toggles whether the character accepts tells
+type NoTellCommand struct{}
+
+// Execute executes the notell command
+func (c *NoTellCommand) Execute(character *types.Character, args string) error {
+	if character.IsNPC {
+		return fmt.Errorf("You don't need to do that.")
+	}
+
+	character.Flags ^= types.PLR_NOTELL
+	if character.Flags&types.PLR_NOTELL != 0 {
+		return fmt.Errorf("You will no longer receive tells.")
+	}
+	return fmt.Errorf("You can now receive tells again.")
+}
+
+// Name returns the name of the command
+func (c *NoTellCommand) Name() string {
+	return "notell"
+}
+
+// Aliases returns the aliases of the command
+func (c *NoTellCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *NoTellCommand) MinPosition() int {
+	return types.POS_DEAD
+}
+
+// Level returns the minimum level required to execute the command
+func (c *NoTellCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *NoTellCommand) LogCommand() bool {
+	return false
+}
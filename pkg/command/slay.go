@@ -25,7 +25,7 @@ func (c *SlayCommand) Execute(character *types.Character, args string) error {
 	}
 
 	// Find the target
-	target := findCharacterInRoom(character.InRoom, args)
+	target := findCharacterInRoom(character, args)
 	if target == nil {
 		return fmt.Errorf("they aren't here")
 	}
@@ -99,8 +99,14 @@ func (c *SlayCommand) Execute(character *types.Character, args string) error {
 	// Award experience if target is NPC (same calculation as normal combat)
 	if target.IsNPC {
 		exp := calculateSlayExperience(character, target)
-		character.Experience += exp
 		character.SendMessage(fmt.Sprintf("You gain %d experience points.\r\n", exp))
+		if w, ok := character.World.(interface {
+			GainExp(*types.Character, int)
+		}); ok {
+			w.GainExp(character, exp)
+		} else {
+			character.Experience += exp
+		}
 	}
 
 	return fmt.Errorf("you slay %s with divine power!\r\n", target.ShortDesc)
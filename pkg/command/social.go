@@ -0,0 +1,181 @@
+package command
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/storage"
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// SocialCommand represents a social action loaded from the actions file
+type SocialCommand struct {
+	Social *types.Social
+}
+
+// Execute executes the social
+func (c *SocialCommand) Execute(character *types.Character, args string) error {
+	world, ok := character.World.(interface {
+		Act(string, bool, *types.Character, *types.ObjectInstance, *types.Character, int)
+	})
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	if character.InRoom == nil {
+		return fmt.Errorf("you are not in a room")
+	}
+
+	social := c.Social
+
+	// Socials without a target ignore any argument
+	targetName := ""
+	if fields := strings.Fields(args); len(fields) > 0 && social.TakesTarget() {
+		targetName = strings.ToLower(fields[0])
+	}
+
+	if targetName == "" {
+		world.Act(social.CharNoArg, false, character, nil, nil, types.TO_CHAR)
+		world.Act(social.OthersNoArg, social.Hide, character, nil, nil, types.TO_ROOM)
+		return nil
+	}
+
+	// Find the target in the room
+	var vict *types.Character
+	if targetName == "self" || targetName == "me" {
+		vict = character
+	} else {
+		character.InRoom.RLock()
+		vict = findCharacterInRoom(character, targetName)
+		character.InRoom.RUnlock()
+	}
+
+	switch {
+	case vict == nil:
+		world.Act(social.NotFound, false, character, nil, nil, types.TO_CHAR)
+	case vict == character:
+		world.Act(social.CharAuto, false, character, nil, nil, types.TO_CHAR)
+		world.Act(social.OthersAuto, social.Hide, character, nil, nil, types.TO_ROOM)
+	case vict.Position < social.MinVictimPosition:
+		world.Act("$N is not in a proper position for that.", false, character, nil, vict, types.TO_CHAR)
+	default:
+		world.Act(social.CharFound, false, character, nil, vict, types.TO_CHAR)
+		world.Act(social.OthersFound, social.Hide, character, nil, vict, types.TO_NOTVICT)
+		world.Act(social.VictFound, social.Hide, character, nil, vict, types.TO_VICT)
+	}
+
+	return nil
+}
+
+// Name returns the name of the command
+func (c *SocialCommand) Name() string {
+	return c.Social.Name
+}
+
+// Aliases returns the aliases of the command
+func (c *SocialCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *SocialCommand) MinPosition() int {
+	return types.POS_RESTING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *SocialCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *SocialCommand) LogCommand() bool {
+	return false
+}
+
+// LoadSocials parses the actions file and registers a command for every
+// social, replacing any socials registered by a previous load. Socials never
+// replace a regular command with the same name.
+func LoadSocials(registry *Registry, filename string) (int, error) {
+	socials, err := storage.ParseSocials(filename)
+	if err != nil {
+		return 0, err
+	}
+
+	// Remove the previously loaded socials
+	for _, cmd := range registry.Commands() {
+		if _, ok := cmd.(*SocialCommand); ok {
+			registry.Unregister(cmd)
+		}
+	}
+
+	count := 0
+	for _, social := range socials {
+		if existing := registry.Find(social.Name); existing != nil {
+			log.Printf("Warning: social %s conflicts with an existing command, skipping", social.Name)
+			continue
+		}
+		registry.Register(&SocialCommand{Social: social})
+		count++
+	}
+
+	return count, nil
+}
+
+// SocialsCommand lists the available socials
+type SocialsCommand struct {
+	Registry *Registry
+}
+
+// Execute executes the socials command
+func (c *SocialsCommand) Execute(character *types.Character, args string) error {
+	var names []string
+	for name, cmd := range c.Registry.Commands() {
+		if _, ok := cmd.(*SocialCommand); ok {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) == 0 {
+		return fmt.Errorf("There are no socials available.")
+	}
+
+	sort.Strings(names)
+
+	var sb strings.Builder
+	sb.WriteString("The following socials are available:\r\n")
+	for i, name := range names {
+		sb.WriteString(fmt.Sprintf("%-12s", name))
+		if (i+1)%6 == 0 {
+			sb.WriteString("\r\n")
+		}
+	}
+
+	return fmt.Errorf("%s", strings.TrimRight(sb.String(), "\r\n "))
+}
+
+// Name returns the name of the command
+func (c *SocialsCommand) Name() string {
+	return "socials"
+}
+
+// Aliases returns the aliases of the command
+func (c *SocialsCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *SocialsCommand) MinPosition() int {
+	return types.POS_DEAD
+}
+
+// Level returns the minimum level required to execute the command
+func (c *SocialsCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *SocialsCommand) LogCommand() bool {
+	return false
+}
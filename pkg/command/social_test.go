@@ -0,0 +1,141 @@
+package command
+
+import (
+	"os"
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// actCall records a call to Act
+type actCall struct {
+	msg     string
+	vict    *types.Character
+	msgType int
+}
+
+// MockWorldForSocial implements the interface needed by SocialCommand
+type MockWorldForSocial struct {
+	calls []actCall
+}
+
+func (m *MockWorldForSocial) Act(msg string, hide bool, ch *types.Character, obj *types.ObjectInstance, vict *types.Character, msgType int) {
+	if msg != "" {
+		m.calls = append(m.calls, actCall{msg: msg, vict: vict, msgType: msgType})
+	}
+}
+
+func TestSocialCommand(t *testing.T) {
+	world := &MockWorldForSocial{}
+	room := &types.Room{VNUM: 3001}
+	actor := &types.Character{Name: "Alice", Position: types.POS_STANDING, InRoom: room, World: world}
+	target := &types.Character{Name: "Bob", Position: types.POS_SLEEPING, InRoom: room, World: world}
+	room.Characters = []*types.Character{actor, target}
+
+	cmd := &SocialCommand{Social: &types.Social{
+		Name:              "dance",
+		MinVictimPosition: types.POS_STANDING,
+		CharNoArg:         "Feels silly, doesn't it?",
+		OthersNoArg:       "$n dances.",
+		CharFound:         "You lead $M to the dancefloor.",
+		OthersFound:       "$n sends $N across the dancefloor.",
+		VictFound:         "$n sends you across the dancefloor.",
+		NotFound:          "Eh, WHO?",
+		CharAuto:          "You dance by yourself.",
+		OthersAuto:        "$n skips a light Fandango.",
+	}}
+
+	tests := []struct {
+		name     string
+		args     string
+		expected []actCall
+	}{
+		{"no argument", "", []actCall{
+			{"Feels silly, doesn't it?", nil, types.TO_CHAR},
+			{"$n dances.", nil, types.TO_ROOM},
+		}},
+		{"not found", "carol", []actCall{
+			{"Eh, WHO?", nil, types.TO_CHAR},
+		}},
+		{"self", "self", []actCall{
+			{"You dance by yourself.", nil, types.TO_CHAR},
+			{"$n skips a light Fandango.", nil, types.TO_ROOM},
+		}},
+		{"target asleep", "bob", []actCall{
+			{"$N is not in a proper position for that.", target, types.TO_CHAR},
+		}},
+	}
+
+	for _, tt := range tests {
+		world.calls = nil
+		if err := cmd.Execute(actor, tt.args); err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if len(world.calls) != len(tt.expected) {
+			t.Fatalf("%s: expected %d messages, got %v", tt.name, len(tt.expected), world.calls)
+		}
+		for i, call := range tt.expected {
+			if world.calls[i] != call {
+				t.Errorf("%s: expected %+v, got %+v", tt.name, call, world.calls[i])
+			}
+		}
+	}
+
+	// Once the target is standing, all three found messages are sent
+	target.Position = types.POS_STANDING
+	world.calls = nil
+	cmd.Execute(actor, "bob")
+	expected := []actCall{
+		{"You lead $M to the dancefloor.", target, types.TO_CHAR},
+		{"$n sends $N across the dancefloor.", target, types.TO_NOTVICT},
+		{"$n sends you across the dancefloor.", target, types.TO_VICT},
+	}
+	if len(world.calls) != len(expected) {
+		t.Fatalf("Expected %d messages, got %v", len(expected), world.calls)
+	}
+	for i, call := range expected {
+		if world.calls[i] != call {
+			t.Errorf("Expected %+v, got %+v", call, world.calls[i])
+		}
+	}
+}
+
+func TestLoadSocials(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(&SayCommand{})
+
+	tmpFile, err := os.CreateTemp("", "actions_test*")
+	if err != nil {
+		t.Fatalf("Failed to create temp file: %v", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	tmpFile.WriteString("22 0 0\nBOING!\n$n bounces around.\n#\n\n1 0 0 say\nYou say.\n#\n#\n\n-1\n")
+	tmpFile.Close()
+
+	count, err := LoadSocials(registry, tmpFile.Name())
+	if err != nil {
+		t.Fatalf("Failed to load socials: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Expected 1 social to be loaded, got %d", count)
+	}
+	if _, ok := registry.Find("bounce").(*SocialCommand); !ok {
+		t.Errorf("Expected bounce to be registered as a social")
+	}
+	if _, ok := registry.Find("say").(*SayCommand); !ok {
+		t.Errorf("Expected say to remain a regular command")
+	}
+
+	// Reloading drops socials removed from the file
+	os.WriteFile(tmpFile.Name(), []byte("500 0 0 wiggle\nYou wiggle.\n#\n#\n\n-1\n"), 0644)
+	if _, err := LoadSocials(registry, tmpFile.Name()); err != nil {
+		t.Fatalf("Failed to reload socials: %v", err)
+	}
+	if registry.Find("bounce") != nil {
+		t.Errorf("Expected bounce to be removed on reload")
+	}
+	if registry.Find("wiggle") == nil {
+		t.Errorf("Expected wiggle to be registered on reload")
+	}
+}
@@ -56,7 +56,7 @@ func (c *StealCommand) Execute(character *types.Character, args string) error {
 	victimName := strings.TrimSpace(parts[1])
 
 	// Find the victim in the room
-	victim := findCharacterInRoom(character.InRoom, victimName)
+	victim := findCharacterInRoom(character, victimName)
 	if victim == nil {
 		return fmt.Errorf("they aren't here")
 	}
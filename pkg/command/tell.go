@@ -0,0 +1,194 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// TellCommand represents the tell command
+type TellCommand struct{}
+
+// Execute executes the tell command
+func (c *TellCommand) Execute(character *types.Character, args string) error {
+	// Split the target name from the message
+	args = strings.TrimSpace(args)
+	parts := strings.SplitN(args, " ", 2)
+	if len(parts) < 2 || strings.TrimSpace(parts[1]) == "" {
+		return fmt.Errorf("Who do you wish to tell what??")
+	}
+
+	// Get the world interface
+	world, ok := character.World.(interface {
+		GetCharacters() map[string]*types.Character
+		SendMessageToCharacter(*types.Character, string)
+	})
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	// Find the target
+	target := findPlayerInWorld(world.GetCharacters(), parts[0])
+	if target == nil {
+		return fmt.Errorf("No-one by that name here..")
+	}
+
+	return sendTell(world, character, target, strings.TrimSpace(parts[1]))
+}
+
+// Name returns the name of the command
+func (c *TellCommand) Name() string {
+	return "tell"
+}
+
+// Aliases returns the aliases of the command
+func (c *TellCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *TellCommand) MinPosition() int {
+	return types.POS_RESTING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *TellCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *TellCommand) LogCommand() bool {
+	return false
+}
+
+// ReplyCommand represents the reply command
+type ReplyCommand struct{}
+
+// Execute executes the reply command
+func (c *ReplyCommand) Execute(character *types.Character, args string) error {
+	// Check if there is anyone to reply to
+	if character.LastTellFrom == "" {
+		return fmt.Errorf("You have no-one to reply to!")
+	}
+
+	// Check if there is a message
+	args = strings.TrimSpace(args)
+	if args == "" {
+		return fmt.Errorf("What is your reply?")
+	}
+
+	// Get the world interface
+	world, ok := character.World.(interface {
+		GetCharacters() map[string]*types.Character
+		SendMessageToCharacter(*types.Character, string)
+	})
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	// The sender may have left the game since they told us something
+	target, ok := world.GetCharacters()[character.LastTellFrom]
+	if !ok || target.IsNPC {
+		return fmt.Errorf("They are no longer playing.")
+	}
+
+	return sendTell(world, character, target, args)
+}
+
+// Name returns the name of the command
+func (c *ReplyCommand) Name() string {
+	return "reply"
+}
+
+// Aliases returns the aliases of the command
+func (c *ReplyCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *ReplyCommand) MinPosition() int {
+	return types.POS_RESTING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *ReplyCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *ReplyCommand) LogCommand() bool {
+	return false
+}
+
+// sendTell delivers a private message from character to target, checking
+// that the target is able to receive it
+func sendTell(world interface {
+	SendMessageToCharacter(*types.Character, string)
+}, character, target *types.Character, message string) error {
+	if target == character {
+		return fmt.Errorf("You try to tell yourself something.")
+	}
+
+	if !character.IsNPC && character.Flags&types.PLR_NOTELL != 0 {
+		return fmt.Errorf("You can't tell other people while you have notell on.")
+	}
+
+	// NPCs have no connection to read tells with
+	if target.IsNPC {
+		return fmt.Errorf("%s doesn't seem to understand you.", getSpeakerName(target))
+	}
+
+	// Players without a client are linkless
+	if target.Client == nil {
+		return fmt.Errorf("%s is linkless right now, try again later.", target.Name)
+	}
+
+	if target.Position == types.POS_SLEEPING {
+		return fmt.Errorf("%s can't hear you.", target.Name)
+	}
+
+	if target.Flags&types.PLR_NOTELL != 0 {
+		return fmt.Errorf("%s isn't listening to tells right now.", target.Name)
+	}
+
+	// Deliver the message and remember who to reply to
+	world.SendMessageToCharacter(target, fmt.Sprintf("%s tells you '%s'\r\n", getSpeakerName(character), message))
+	target.LastTellFrom = character.Name
+
+	return fmt.Errorf("You tell %s '%s'", target.Name, message)
+}
+
+// findPlayerInWorld finds a player (not an NPC) in the game by name prefix
+func findPlayerInWorld(characters map[string]*types.Character, name string) *types.Character {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return nil
+	}
+
+	// Prefer an exact match over a prefix match
+	var prefixMatch *types.Character
+	for _, ch := range characters {
+		if ch.IsNPC {
+			continue
+		}
+
+		chName := strings.ToLower(ch.Name)
+		if chName == name {
+			return ch
+		}
+		if prefixMatch == nil && strings.HasPrefix(chName, name) {
+			prefixMatch = ch
+		}
+	}
+
+	return prefixMatch
+}
+
+// getSpeakerName returns the name others see when a character speaks
+func getSpeakerName(ch *types.Character) string {
+	if ch.IsNPC && ch.ShortDesc != "" {
+		return ch.ShortDesc
+	}
+	return ch.Name
+}
@@ -0,0 +1,159 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// MockWorldForTell implements the interface needed by the communication commands
+type MockWorldForTell struct {
+	characters map[string]*types.Character
+	zones      map[int]*types.Zone
+	messages   map[*types.Character][]string
+}
+
+func (m *MockWorldForTell) GetCharacters() map[string]*types.Character {
+	return m.characters
+}
+
+func (m *MockWorldForTell) SendMessageToCharacter(ch *types.Character, message string) {
+	m.messages[ch] = append(m.messages[ch], message)
+}
+
+func (m *MockWorldForTell) GetZoneForRoom(vnum int) *types.Zone {
+	return m.zones[vnum]
+}
+
+func newMockWorldForTell() *MockWorldForTell {
+	return &MockWorldForTell{
+		characters: make(map[string]*types.Character),
+		zones:      make(map[int]*types.Zone),
+		messages:   make(map[*types.Character][]string),
+	}
+}
+
+func (m *MockWorldForTell) addPlayer(name string, room *types.Room) *types.Character {
+	ch := &types.Character{
+		Name:     name,
+		Position: types.POS_STANDING,
+		InRoom:   room,
+		Client:   struct{}{},
+		World:    m,
+	}
+	m.characters[name] = ch
+	return ch
+}
+
+func TestTellAndReply(t *testing.T) {
+	world := newMockWorldForTell()
+	alice := world.addPlayer("Alice", nil)
+	bob := world.addPlayer("Bob", nil)
+
+	err := (&TellCommand{}).Execute(alice, "bo hello there")
+	if err == nil || err.Error() != "You tell Bob 'hello there'" {
+		t.Fatalf("Unexpected tell result: %v", err)
+	}
+	if len(world.messages[bob]) != 1 || world.messages[bob][0] != "Alice tells you 'hello there'\r\n" {
+		t.Errorf("Bob did not receive the tell: %v", world.messages[bob])
+	}
+	if bob.LastTellFrom != "Alice" {
+		t.Errorf("Expected LastTellFrom to be Alice, got %q", bob.LastTellFrom)
+	}
+
+	err = (&ReplyCommand{}).Execute(bob, "hi")
+	if err == nil || err.Error() != "You tell Alice 'hi'" {
+		t.Fatalf("Unexpected reply result: %v", err)
+	}
+
+	// Reply fails once the sender has left
+	delete(world.characters, "Bob")
+	err = (&ReplyCommand{}).Execute(alice, "still there?")
+	if err == nil || err.Error() != "They are no longer playing." {
+		t.Errorf("Expected no longer playing error, got: %v", err)
+	}
+}
+
+func TestTellRestrictions(t *testing.T) {
+	world := newMockWorldForTell()
+	alice := world.addPlayer("Alice", nil)
+	bob := world.addPlayer("Bob", nil)
+	world.characters["guard"] = &types.Character{Name: "guard", ShortDesc: "the cityguard", IsNPC: true}
+
+	tests := []struct {
+		name   string
+		setup  func()
+		args   string
+		expect string
+	}{
+		{"no target", func() {}, "nobody hi", "No-one by that name here.."},
+		{"npc", func() {}, "guard hi", "No-one by that name here.."},
+		{"linkless", func() { bob.Client = nil }, "bob hi", "Bob is linkless right now, try again later."},
+		{"sleeping", func() { bob.Position = types.POS_SLEEPING }, "bob hi", "Bob can't hear you."},
+		{"notell", func() { bob.Flags |= types.PLR_NOTELL }, "bob hi", "Bob isn't listening to tells right now."},
+		{"self", func() {}, "alice hi", "You try to tell yourself something."},
+	}
+
+	for _, tt := range tests {
+		bob.Client = struct{}{}
+		bob.Position = types.POS_STANDING
+		bob.Flags = 0
+		tt.setup()
+
+		err := (&TellCommand{}).Execute(alice, tt.args)
+		if err == nil || err.Error() != tt.expect {
+			t.Errorf("%s: expected %q, got %v", tt.name, tt.expect, err)
+		}
+	}
+
+	if len(world.messages[bob]) != 0 {
+		t.Errorf("Bob should not have received any tells: %v", world.messages[bob])
+	}
+}
+
+func TestShoutAndYell(t *testing.T) {
+	world := newMockWorldForTell()
+	room1 := &types.Room{VNUM: 3001}
+	room2 := &types.Room{VNUM: 3002}
+	room3 := &types.Room{VNUM: 6001}
+	world.zones[3001] = &types.Zone{VNUM: 30}
+	world.zones[3002] = world.zones[3001]
+	world.zones[6001] = &types.Zone{VNUM: 60}
+
+	alice := world.addPlayer("Alice", room1)
+	bob := world.addPlayer("Bob", room2)
+	carol := world.addPlayer("Carol", room3)
+	dave := world.addPlayer("Dave", room2)
+	dave.Flags |= types.PLR_DEAF
+
+	err := (&YellCommand{}).Execute(alice, "help")
+	if err == nil || err.Error() != "You yell 'help'" {
+		t.Fatalf("Unexpected yell result: %v", err)
+	}
+	if len(world.messages[bob]) != 1 {
+		t.Errorf("Bob should hear a yell in the same zone")
+	}
+	if len(world.messages[carol]) != 0 {
+		t.Errorf("Carol should not hear a yell from another zone")
+	}
+	if len(world.messages[dave]) != 0 {
+		t.Errorf("Dave is deaf and should not hear a yell")
+	}
+
+	err = (&ShoutCommand{}).Execute(alice, "hello world")
+	if err == nil || !strings.HasPrefix(err.Error(), "You shout") {
+		t.Fatalf("Unexpected shout result: %v", err)
+	}
+	if len(world.messages[carol]) != 1 || world.messages[carol][0] != "Alice shouts 'hello world'\r\n" {
+		t.Errorf("Carol should hear a shout from anywhere: %v", world.messages[carol])
+	}
+	if len(world.messages[dave]) != 0 {
+		t.Errorf("Dave is deaf and should not hear a shout")
+	}
+
+	err = (&ShoutCommand{}).Execute(dave, "hello")
+	if err == nil || err.Error() != "You can't shout while you are deaf!" {
+		t.Errorf("Expected deaf shout error, got: %v", err)
+	}
+}
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// TextFileCommand shows one of the static text files, such as the news
+type TextFileCommand struct {
+	file string
+}
+
+// Execute executes the text file command
+func (c *TextFileCommand) Execute(character *types.Character, args string) error {
+	world, ok := character.World.(interface {
+		TextFile(name string) string
+	})
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	text := world.TextFile(c.file)
+	if text == "" {
+		return fmt.Errorf("Sorry, there is no %s at the moment.", c.file)
+	}
+
+	character.PageString(text)
+	return nil
+}
+
+// Name returns the name of the command
+func (c *TextFileCommand) Name() string {
+	return c.file
+}
+
+// Aliases returns the aliases of the command
+func (c *TextFileCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *TextFileCommand) MinPosition() int {
+	return types.POS_SLEEPING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *TextFileCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *TextFileCommand) LogCommand() bool {
+	return false
+}
@@ -0,0 +1,74 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// MockWorldForText serves text files and records messages
+type MockWorldForText struct {
+	files    map[string]string
+	messages []string
+}
+
+func (m *MockWorldForText) TextFile(name string) string {
+	return m.files[name]
+}
+
+func (m *MockWorldForText) SendMessageToCharacter(ch *types.Character, message string) {
+	m.messages = append(m.messages, message)
+}
+
+func TestTextFileCommandPaging(t *testing.T) {
+	var lines []string
+	for i := 1; i <= types.PAGE_LENGTH+5; i++ {
+		lines = append(lines, fmt.Sprintf("Line %d", i))
+	}
+	world := &MockWorldForText{files: map[string]string{
+		"credits": "DikuMUD was written by...",
+		"news":    strings.Join(lines, "\r\n"),
+	}}
+	ch := &types.Character{Name: "Reader", World: world}
+
+	// Missing files say so
+	if err := (&TextFileCommand{file: "policy"}).Execute(ch, ""); err == nil || !strings.Contains(err.Error(), "no policy") {
+		t.Errorf("Expected no policy, got %v", err)
+	}
+
+	// Short files are shown in one go
+	if err := (&TextFileCommand{file: "credits"}).Execute(ch, ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if ch.IsPaging() || len(world.messages) != 1 {
+		t.Errorf("Expected credits on one page, got %q", world.messages)
+	}
+	world.messages = nil
+
+	// Long files are paged
+	if err := (&TextFileCommand{file: "news"}).Execute(ch, ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !ch.IsPaging() || !strings.Contains(world.messages[0], fmt.Sprintf("Line %d\r\n", types.PAGE_LENGTH)) ||
+		strings.Contains(world.messages[0], fmt.Sprintf("Line %d\r\n", types.PAGE_LENGTH+1)) {
+		t.Fatalf("Expected the first page, got %q", world.messages)
+	}
+	if !strings.Contains(world.messages[1], "(1/2)") {
+		t.Errorf("Expected the pager prompt, got %q", world.messages[1])
+	}
+	world.messages = nil
+
+	ch.ShowPage("")
+	if ch.IsPaging() || !strings.HasPrefix(world.messages[0], fmt.Sprintf("Line %d", types.PAGE_LENGTH+1)) {
+		t.Errorf("Expected the last page to end paging, got %q", world.messages)
+	}
+
+	// Quitting stops paging
+	(&TextFileCommand{file: "news"}).Execute(ch, "")
+	ch.ShowPage("q")
+	if ch.IsPaging() {
+		t.Error("Expected q to stop paging")
+	}
+}
@@ -70,10 +70,13 @@ func useStaff(ch *types.Character, staff *types.ObjectInstance, world *world.Wor
 		return nil
 	}
 
-	// Cast the spell on everyone in the room except the caster
-	for _, victim := range ch.InRoom.Characters {
+	// Cast the spell on everyone in the room except the caster. Copy the
+	// list first since a spell can move or kill its target.
+	victims := make([]*types.Character, len(ch.InRoom.Characters))
+	copy(victims, ch.InRoom.Characters)
+	for _, victim := range victims {
 		if victim != ch {
-			DoCast(ch, fmt.Sprintf("'%s' %s", types.GetSpellName(spellID), victim.Name), world)
+			world.CastSpell(spellID, staff.Prototype.Value[0], ch, "", types.SPELL_TYPE_STAFF, victim, nil)
 		}
 	}
 
@@ -87,47 +90,19 @@ func useWand(ch *types.Character, wand *types.ObjectInstance, targetArg string,
 		return fmt.Errorf("what should the wand be pointed at?")
 	}
 
-	// Find the target (character or object)
-	var victim *types.Character
-	var obj *types.ObjectInstance
-
-	// Try to find a character target
-	victim = world.GetCharacterInRoom(ch.InRoom, targetArg)
-
-	// If no character found, try to find an object target
-	if victim == nil {
-		// Check inventory
-		for _, o := range ch.Inventory {
-			if strings.Contains(strings.ToLower(o.Prototype.Name), targetArg) {
-				obj = o
-				break
-			}
-		}
-
-		// Check room
-		if obj == nil {
-			for _, o := range ch.InRoom.Objects {
-				if strings.Contains(strings.ToLower(o.Prototype.Name), targetArg) {
-					obj = o
-					break
-				}
-			}
-		}
+	// Get spell ID
+	spellID := wand.Prototype.Value[3]
 
-		// Check equipment
-		if obj == nil {
-			for _, o := range ch.Equipment {
-				if o != nil && strings.Contains(strings.ToLower(o.Prototype.Name), targetArg) {
-					obj = o
-					break
-				}
-			}
-		}
+	// Check if spell ID is valid
+	if spellID <= 0 || spellID >= types.MAX_SPELLS {
+		ch.SendMessage("The wand seems to have a magical malfunction.\r\n")
+		return nil
 	}
 
-	// Check if a target was found
-	if victim == nil && obj == nil {
-		return fmt.Errorf("nothing by that name here")
+	// The wand's spell decides what it can be pointed at
+	victim, obj, err := world.GetSpellTarget(ch, targetArg, spellID)
+	if err != nil {
+		return err
 	}
 
 	// Send messages
@@ -148,17 +123,10 @@ func useWand(ch *types.Character, wand *types.ObjectInstance, targetArg string,
 	// Decrement charges
 	wand.Prototype.Value[2]--
 
-	// Get spell ID
-	spellID := wand.Prototype.Value[3]
-
-	// Check if spell ID is valid
-	if spellID <= 0 || spellID >= types.MAX_SPELLS {
-		ch.SendMessage("The wand seems to have a magical malfunction.\r\n")
-		return nil
+	// The wand casts at its own level, the user's mana isn't touched
+	if err := world.CastSpell(spellID, wand.Prototype.Value[0], ch, targetArg, types.SPELL_TYPE_WAND, victim, obj); err != nil {
+		return err
 	}
 
-	// Cast the spell using the cast command
-	DoCast(ch, fmt.Sprintf("'%s' %s", types.GetSpellName(spellID), targetArg), world)
-
 	return nil
 }
@@ -0,0 +1,95 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// canSee returns true if viewer can see target. Worlds that don't know
+// about visibility show everyone.
+func canSee(viewer, target *types.Character) bool {
+	world, ok := viewer.World.(interface {
+		CanSee(*types.Character, *types.Character) bool
+	})
+	if !ok {
+		return true
+	}
+	return world.CanSee(viewer, target)
+}
+
+// canSeeObj returns true if viewer can see obj. Worlds that don't know
+// about visibility show everything.
+func canSeeObj(viewer *types.Character, obj *types.ObjectInstance) bool {
+	world, ok := viewer.World.(interface {
+		CanSeeObj(*types.Character, *types.ObjectInstance) bool
+	})
+	if !ok {
+		return true
+	}
+	return world.CanSeeObj(viewer, obj)
+}
+
+// tooDark returns true if the character's room is too dark for them to make
+// anything out
+func tooDark(character *types.Character) bool {
+	world, ok := character.World.(interface {
+		IsDark(*types.Room) bool
+		CanSeeInDark(*types.Character) bool
+	})
+	if !ok {
+		return false
+	}
+	return world.IsDark(character.InRoom) && !world.CanSeeInDark(character)
+}
+
+// updateRoomLight recounts the lights in the character's room after a light
+// has been picked up, dropped, held or put away
+func updateRoomLight(character *types.Character) {
+	if world, ok := character.World.(interface {
+		UpdateRoomLight(*types.Room)
+	}); ok {
+		world.UpdateRoomLight(character.InRoom)
+	}
+}
+
+// HolylightCommand toggles whether an immortal sees everything
+type HolylightCommand struct{}
+
+// Execute executes the holylight command
+func (c *HolylightCommand) Execute(character *types.Character, args string) error {
+	if character.IsNPC {
+		return fmt.Errorf("You don't need to do that.")
+	}
+
+	character.Flags ^= types.PLR_HOLYLIGHT
+	if character.Flags&types.PLR_HOLYLIGHT != 0 {
+		return fmt.Errorf("Your eyes are opened to all things.")
+	}
+	return fmt.Errorf("You see the world as mortals do.")
+}
+
+// Name returns the name of the command
+func (c *HolylightCommand) Name() string {
+	return "holylight"
+}
+
+// Aliases returns the aliases of the command
+func (c *HolylightCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *HolylightCommand) MinPosition() int {
+	return types.POS_DEAD
+}
+
+// Level returns the minimum level required to execute the command
+func (c *HolylightCommand) Level() int {
+	return types.LEVEL_IMMORTAL
+}
+
+// LogCommand returns whether the command should be logged
+func (c *HolylightCommand) LogCommand() bool {
+	return false
+}
@@ -0,0 +1,142 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// WhisperCommand represents the whisper command
+type WhisperCommand struct{}
+
+// Execute executes the whisper command
+func (c *WhisperCommand) Execute(character *types.Character, args string) error {
+	target, message, err := findRoomListener(character, args, "Who do you want to whisper to.. and what??")
+	if err != nil {
+		return err
+	}
+
+	if target == character {
+		return fmt.Errorf("You can't seem to get your mouth close enough to your ear...")
+	}
+
+	return speakToListener(character, target,
+		fmt.Sprintf("%s whispers to you, '%s'\r\n", getSpeakerName(character), message),
+		"$n whispers something to $N.",
+		fmt.Sprintf("You whisper to %s, '%s'", getSpeakerName(target), message))
+}
+
+// Name returns the name of the command
+func (c *WhisperCommand) Name() string {
+	return "whisper"
+}
+
+// Aliases returns the aliases of the command
+func (c *WhisperCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *WhisperCommand) MinPosition() int {
+	return types.POS_RESTING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *WhisperCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *WhisperCommand) LogCommand() bool {
+	return false
+}
+
+// AskCommand represents the ask command
+type AskCommand struct{}
+
+// Execute executes the ask command
+func (c *AskCommand) Execute(character *types.Character, args string) error {
+	target, message, err := findRoomListener(character, args, "Who do you want to ask something.. and what??")
+	if err != nil {
+		return err
+	}
+
+	if target == character {
+		return fmt.Errorf("You think about it for a while...")
+	}
+
+	return speakToListener(character, target,
+		fmt.Sprintf("%s asks you '%s'\r\n", getSpeakerName(character), message),
+		"$n asks $N a question.",
+		fmt.Sprintf("You ask %s '%s'", getSpeakerName(target), message))
+}
+
+// Name returns the name of the command
+func (c *AskCommand) Name() string {
+	return "ask"
+}
+
+// Aliases returns the aliases of the command
+func (c *AskCommand) Aliases() []string {
+	return []string{}
+}
+
+// MinPosition returns the minimum position required to execute the command
+func (c *AskCommand) MinPosition() int {
+	return types.POS_RESTING
+}
+
+// Level returns the minimum level required to execute the command
+func (c *AskCommand) Level() int {
+	return 0
+}
+
+// LogCommand returns whether the command should be logged
+func (c *AskCommand) LogCommand() bool {
+	return false
+}
+
+// findRoomListener parses "<target> <message>" and finds the target in the
+// character's room
+func findRoomListener(character *types.Character, args string, usage string) (*types.Character, string, error) {
+	args = strings.TrimSpace(args)
+	parts := strings.SplitN(args, " ", 2)
+	if len(parts) < 2 || strings.TrimSpace(parts[1]) == "" {
+		return nil, "", fmt.Errorf("%s", usage)
+	}
+
+	if character.InRoom == nil {
+		return nil, "", fmt.Errorf("you are not in a room")
+	}
+
+	character.InRoom.RLock()
+	target := findCharacterInRoom(character, parts[0])
+	character.InRoom.RUnlock()
+	if target == nil {
+		return nil, "", fmt.Errorf("No-one by that name here..")
+	}
+
+	return target, strings.TrimSpace(parts[1]), nil
+}
+
+// speakToListener delivers a message to someone in the same room and shows
+// the rest of the room that something was said
+func speakToListener(character, target *types.Character, targetMsg, roomMsg, charMsg string) error {
+	world, ok := character.World.(interface {
+		SendMessageToCharacter(*types.Character, string)
+		Act(string, bool, *types.Character, *types.ObjectInstance, *types.Character, int)
+	})
+	if !ok {
+		return fmt.Errorf("world interface not available")
+	}
+
+	if target.Position == types.POS_SLEEPING {
+		return fmt.Errorf("%s can't hear you.", getSpeakerName(target))
+	}
+
+	world.SendMessageToCharacter(target, targetMsg)
+	world.Act(roomMsg, true, character, nil, target, types.TO_NOTVICT)
+
+	return fmt.Errorf("%s", charMsg)
+}
@@ -30,7 +30,7 @@ func (c *WhoCommand) Execute(character *types.Character, args string) error {
 
 	// Add each character to the list
 	for _, ch := range characters {
-		if !ch.IsNPCFlag() {
+		if !ch.IsNPCFlag() && canSee(character, ch) {
 			sb.WriteString(fmt.Sprintf("[%2d] %s%s\r\n", ch.Level, ch.Name, ch.Title))
 		}
 	}
@@ -23,6 +23,12 @@ func registerSpecialProcs(w *world.World) {
 				mobile.Functions = append(mobile.Functions, proc)
 			}
 		}
+		for name, proc := range ai.ReceiveProcs {
+			if strings.Contains(strings.ToLower(mobile.Name), name) {
+				log.Printf("Registering receive procedure %s for mobile %s", name, mobile.Name)
+				mobile.Receivers = append(mobile.Receivers, proc)
+			}
+		}
 
 		// Register special procedures based on flags
 		if mobile.ActFlags&types.ACT_SCAVENGER != 0 {
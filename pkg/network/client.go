@@ -106,10 +106,11 @@ func (c *Client) Handle() {
 					// Timeout - continue the loop to check for shutdown
 					continue
 				}
-				// Other error - client disconnected
+				// Other error - client disconnected, fall through to the
+				// clean up below
 				log.Printf("Error reading from client %s: %v", c.ID, err)
 				c.Closed = true
-				return
+				break
 			}
 
 			// Clear the read deadline
@@ -155,6 +156,10 @@ func (c *Client) Handle() {
 
 	// Clean up
 	if c.Character != nil {
+		// Only what is left with a receptionist is kept, so a character who
+		// drops the link leaves everything behind as if they had quit
+		c.World.DropAllObjects(c.Character)
+
 		// Remove character from world
 		c.World.RemoveCharacter(c.Character)
 
@@ -281,9 +286,14 @@ func (c *Client) HandleGetPassword(password string) {
 		return
 	}
 
-	// Password is correct, show menu
+	// Password is correct, show the MOTD before the menu
 	c.Character = character
 	c.Write(fmt.Sprintf("\r\nWelcome back, %s!\r\n", character.Name))
+	if motd := c.World.TextFile("motd"); motd != "" {
+		c.Write("\r\n" + motd + "\r\n\r\n*** PRESS RETURN: ")
+		c.State = StateReadMOTD
+		return
+	}
 	c.Write(ui.Menu)
 	c.State = StateMainMenu
 }
@@ -362,6 +372,14 @@ func (c *Client) HandleMainMenu(input string) {
 		// Set the World field in the character
 		c.Character.World = c.World
 
+		// Collect anything left with the receptionist and pay the rent
+		c.World.ReturnRentedObjects(c.Character)
+
+		// Let the character know about letters at the post office
+		if c.World.HasMail(c.Character.Name) {
+			c.Write("You have mail waiting.\r\n")
+		}
+
 		// Enter game
 		c.State = StatePlaying
 
@@ -540,6 +558,24 @@ func (c *Client) HandleConfirmNewPasswordChange(confirm string) {
 
 // HandleCommand handles a game command
 func (c *Client) HandleCommand(input string) {
+	// Input goes to the editor while a text is being written
+	if c.Character != nil && c.Character.IsEditing() {
+		c.Character.EditLine(input)
+		if !c.Character.IsEditing() {
+			c.Write(c.CommandRegistry.FormatPrompt(c.Character))
+		}
+		return
+	}
+
+	// Input goes to the pager while long output is being shown
+	if c.Character != nil && c.Character.IsPaging() {
+		c.Character.ShowPage(input)
+		if !c.Character.IsPaging() {
+			c.Write(c.CommandRegistry.FormatPrompt(c.Character))
+		}
+		return
+	}
+
 	if input == "" {
 		c.Write("Enter your command: ")
 		return
@@ -589,6 +625,11 @@ func (c *Client) HandleCommand(input string) {
 		return
 	}
 
+	// The pager shows its own prompt, and there is none while writing
+	if c.Character != nil && (c.Character.IsPaging() || c.Character.IsEditing()) {
+		return
+	}
+
 	// Get the formatted prompt
 	if c.CommandRegistry != nil && c.Character != nil {
 		// Use the FormatPrompt function directly
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/wltechblog/DikuGo/pkg/command"
+	"github.com/wltechblog/DikuGo/pkg/types"
 	"github.com/wltechblog/DikuGo/pkg/world"
 )
 
@@ -200,6 +201,69 @@ func (e *timeoutError) Temporary() bool {
 	return true
 }
 
+func TestClientDisconnectLeavesObjectsBehind(t *testing.T) {
+	storage := world.NewMockStorage()
+	w, err := world.NewWorld(nil, storage)
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	room := &types.Room{VNUM: 3001}
+	character := &types.Character{
+		Name:      "Alice",
+		Position:  types.POS_STANDING,
+		Equipment: make([]*types.ObjectInstance, types.NUM_WEARS),
+		World:     w,
+	}
+	w.CharToRoom(character, room)
+
+	sword := &types.ObjectInstance{
+		Prototype: &types.Object{VNUM: 3022, Name: "sword", ShortDesc: "a long sword"},
+		WornBy:    character,
+		WornOn:    types.WEAR_WIELD,
+	}
+	character.Equipment[types.WEAR_WIELD] = sword
+	bread := &types.ObjectInstance{
+		Prototype: &types.Object{VNUM: 3010, Name: "bread", ShortDesc: "a loaf of bread"},
+		CarriedBy: character,
+		WornOn:    -1,
+	}
+	character.Inventory = append(character.Inventory, bread)
+
+	// Drop the link while playing
+	mockConn := &MockConn{readError: net.ErrClosed}
+	client := NewClient(mockConn, w, command.NewRegistry())
+	client.Character = character
+	client.State = StatePlaying
+
+	done := make(chan bool)
+	go func() {
+		client.Handle()
+		done <- true
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Client did not finish handling within timeout")
+	}
+
+	if character.Equipment[types.WEAR_WIELD] != nil || len(character.Inventory) != 0 {
+		t.Error("Expected the character to carry nothing after dropping the link")
+	}
+	if len(room.Objects) != 2 || sword.InRoom != room || bread.InRoom != room {
+		t.Errorf("Expected both objects to be left in the room, got %d", len(room.Objects))
+	}
+
+	// Nothing was left with a receptionist, so nothing comes back
+	rented, err := storage.LoadPlayerObjects("Alice")
+	if err != nil {
+		t.Fatalf("Failed to load rented objects: %v", err)
+	}
+	if len(rented) != 0 {
+		t.Errorf("Expected no rented objects, got %d", len(rented))
+	}
+}
+
 func TestClientCloseIdempotent(t *testing.T) {
 	// Create a mock world and command registry
 	storage := world.NewMockStorage()
@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/wltechblog/DikuGo/pkg/ai"
 	"github.com/wltechblog/DikuGo/pkg/combat"
 	"github.com/wltechblog/DikuGo/pkg/command"
 	"github.com/wltechblog/DikuGo/pkg/config"
@@ -31,6 +32,7 @@ func NewServer(cfg *config.Config, w *world.World) (*Server, error) {
 	// Create combat manager
 	// Use the EnhancedDikuCombatManager for authentic DikuMUD combat with enhancements
 	combatManager := combat.NewEnhancedDikuCombatManager()
+	combatManager.SpecialAttack = ai.HandleCombatProcs
 
 	// Initialize command registry
 	cmdRegistry := command.InitRegistry(w, combatManager)
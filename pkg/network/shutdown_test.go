@@ -23,6 +23,16 @@ func (m *MockStorage) SaveCharacter(character *types.Character) error      { ret
 func (m *MockStorage) LoadCharacter(name string) (*types.Character, error) { return nil, nil }
 func (m *MockStorage) DeleteCharacter(name string) error                   { return nil }
 func (m *MockStorage) CharacterExists(name string) bool                    { return false }
+func (m *MockStorage) LoadPlayerObjects(name string) ([]*types.ObjectInstance, error) {
+	return nil, nil
+}
+func (m *MockStorage) SavePlayerObjects(name string, objects []*types.ObjectInstance) error {
+	return nil
+}
+func (m *MockStorage) LoadPlayerMail(name string) ([]*types.MailMessage, error) { return nil, nil }
+func (m *MockStorage) SavePlayerMail(name string, mail []*types.MailMessage) error {
+	return nil
+}
 
 func TestClientShutdown(t *testing.T) {
 	// Create a mock world
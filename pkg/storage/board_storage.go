@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// LoadBoardMessages loads the messages of every bulletin board, keyed by
+// the board's object vnum. A missing or empty file holds no messages.
+func LoadBoardMessages(filename string) (map[int][]*types.BoardMessage, error) {
+	boards := make(map[int][]*types.BoardMessage)
+
+	data, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return boards, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read board file: %w", err)
+	}
+	if strings.TrimSpace(string(data)) == "" {
+		return boards, nil
+	}
+
+	var saved map[string][]*types.BoardMessage
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal board messages: %w", err)
+	}
+
+	for key, messages := range saved {
+		vnum, err := strconv.Atoi(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid board vnum %q: %w", key, err)
+		}
+		boards[vnum] = messages
+	}
+
+	return boards, nil
+}
+
+// SaveBoardMessages saves the messages of every bulletin board, keyed by the
+// board's object vnum
+func SaveBoardMessages(filename string, boards map[int][]*types.BoardMessage) error {
+	saved := make(map[string][]*types.BoardMessage, len(boards))
+	for vnum, messages := range boards {
+		saved[strconv.Itoa(vnum)] = messages
+	}
+
+	data, err := json.MarshalIndent(saved, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal board messages: %w", err)
+	}
+
+	if err := os.WriteFile(filename, data, 0644); err != nil {
+		return fmt.Errorf("failed to write board file: %w", err)
+	}
+
+	return nil
+}
@@ -229,13 +229,49 @@ func (fs *FileStorage) CharacterExists(name string) bool {
 // LoadPlayerObjects loads a player's objects from the rent file
 func (fs *FileStorage) LoadPlayerObjects(name string) ([]*types.ObjectInstance, error) {
 	log.Println("Loading objects for player", name)
-	// TODO: Implement player object loading from rent file
-	return []*types.ObjectInstance{}, nil
+
+	// Create player storage
+	playerStorage, err := NewFilePlayerStorage(fs.playerDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create player storage: %w", err)
+	}
+
+	return playerStorage.LoadObjects(name)
 }
 
 // SavePlayerObjects saves a player's objects to the rent file
 func (fs *FileStorage) SavePlayerObjects(name string, objects []*types.ObjectInstance) error {
 	log.Println("Saving objects for player", name)
-	// TODO: Implement player object saving to rent file
-	return nil
+
+	// Create player storage
+	playerStorage, err := NewFilePlayerStorage(fs.playerDir)
+	if err != nil {
+		return fmt.Errorf("failed to create player storage: %w", err)
+	}
+
+	return playerStorage.SaveObjects(name, objects)
+}
+
+// LoadPlayerMail loads the mail waiting for a player
+func (fs *FileStorage) LoadPlayerMail(name string) ([]*types.MailMessage, error) {
+	// Create player storage
+	playerStorage, err := NewFilePlayerStorage(fs.playerDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create player storage: %w", err)
+	}
+
+	return playerStorage.LoadMail(name)
+}
+
+// SavePlayerMail saves the mail waiting for a player
+func (fs *FileStorage) SavePlayerMail(name string, mail []*types.MailMessage) error {
+	log.Println("Saving mail for player", name)
+
+	// Create player storage
+	playerStorage, err := NewFilePlayerStorage(fs.playerDir)
+	if err != nil {
+		return fmt.Errorf("failed to create player storage: %w", err)
+	}
+
+	return playerStorage.SaveMail(name, mail)
 }
@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// ParseHelpTable parses the help table file and returns its entries in file
+// order.
+//
+// Each entry starts with a line of keywords separated by spaces, where
+// keywords of more than one word are put in double quotes, followed by the
+// help text. Entries end with a line starting with "#" and the file ends
+// with "#~".
+func ParseHelpTable(filename string) ([]*types.HelpEntry, error) {
+	parser, err := NewParser(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer parser.Close()
+
+	var entries []*types.HelpEntry
+	var lines []string
+	for parser.NextLine() {
+		line := parser.Line()
+		if !strings.HasPrefix(line, "#") {
+			lines = append(lines, strings.TrimRight(line, " \t"))
+			continue
+		}
+
+		if len(lines) > 0 {
+			entries = append(entries, &types.HelpEntry{
+				Keywords: parseHelpKeywords(lines[0]),
+				Text:     strings.Join(lines, "\n"),
+			})
+		}
+		lines = nil
+
+		if strings.HasPrefix(line, "#~") {
+			break
+		}
+	}
+
+	return entries, nil
+}
+
+// parseHelpKeywords splits a keyword line into its keywords, keeping quoted
+// keywords together
+func parseHelpKeywords(line string) []string {
+	var keywords []string
+	for line = strings.TrimSpace(line); line != ""; line = strings.TrimSpace(line) {
+		var keyword string
+		if line[0] == '"' {
+			end := strings.IndexByte(line[1:], '"')
+			if end < 0 {
+				keyword, line = line[1:], ""
+			} else {
+				keyword, line = line[1:end+1], line[end+2:]
+			}
+		} else if end := strings.IndexAny(line, " \t"); end >= 0 {
+			keyword, line = line[:end], line[end:]
+		} else {
+			keyword, line = line, ""
+		}
+
+		if keyword = strings.ToUpper(strings.TrimSpace(keyword)); keyword != "" {
+			keywords = append(keywords, keyword)
+		}
+	}
+	return keywords
+}
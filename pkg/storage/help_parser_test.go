@@ -0,0 +1,32 @@
+package storage
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseHelpTable(t *testing.T) {
+	entries, err := ParseHelpTable("../../lib/help_table")
+	if err != nil {
+		t.Fatalf("Failed to parse lib/help_table: %v", err)
+	}
+
+	if len(entries) != 133 {
+		t.Fatalf("Expected 133 help entries, got %d", len(entries))
+	}
+
+	first := entries[0]
+	if len(first.Keywords) != 1 || first.Keywords[0] != "PICK LOCKS" {
+		t.Errorf("Expected the quoted keyword PICK LOCKS, got %q", first.Keywords)
+	}
+	if !strings.Contains(first.Text, "For picking locks.") {
+		t.Errorf("Unexpected help text: %q", first.Text)
+	}
+
+	for _, entry := range entries {
+		if strings.Join(entry.Keywords, " ") == "MAGIC USER WIZARD MAGE" {
+			return
+		}
+	}
+	t.Error("Expected an entry for MAGIC USER, WIZARD and MAGE")
+}
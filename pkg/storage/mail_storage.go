@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// SaveMail saves the mail waiting for a player. Saving no mail removes the
+// mail file.
+func (s *FilePlayerStorage) SaveMail(name string, mail []*types.MailMessage) error {
+	filePath := s.getMailFilePath(name)
+
+	if len(mail) == 0 {
+		if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove mail file: %w", err)
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return fmt.Errorf("failed to create mail directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(mail, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal mail: %w", err)
+	}
+
+	if err := os.WriteFile(filePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write mail file: %w", err)
+	}
+
+	return nil
+}
+
+// LoadMail loads the mail waiting for a player, oldest first. A player
+// without a mail file has no mail.
+func (s *FilePlayerStorage) LoadMail(name string) ([]*types.MailMessage, error) {
+	data, err := os.ReadFile(s.getMailFilePath(name))
+	if os.IsNotExist(err) {
+		return []*types.MailMessage{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mail file: %w", err)
+	}
+
+	var mail []*types.MailMessage
+	if err := json.Unmarshal(data, &mail); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal mail: %w", err)
+	}
+
+	return mail, nil
+}
+
+// getMailFilePath returns the mail file path for a player. Mail files live
+// in their own directory so they are not mistaken for player files.
+func (s *FilePlayerStorage) getMailFilePath(name string) string {
+	return filepath.Join(s.playerDir, "mail", strings.ToLower(name)+".json")
+}
@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// TestMailRoundTrip tests that mail is kept per recipient until cleared
+func TestMailRoundTrip(t *testing.T) {
+	storage, err := NewFilePlayerStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create storage: %v", err)
+	}
+
+	mail := []*types.MailMessage{{From: "Alice", To: "Bob", Text: "Hello"}}
+	if err := storage.SaveMail("Bob", mail); err != nil {
+		t.Fatalf("Failed to save mail: %v", err)
+	}
+
+	loaded, err := storage.LoadMail("bob")
+	if err != nil {
+		t.Fatalf("Failed to load mail: %v", err)
+	}
+	if len(loaded) != 1 || loaded[0].From != "Alice" || loaded[0].Text != "Hello" {
+		t.Errorf("Unexpected mail: %+v", loaded)
+	}
+
+	if other, _ := storage.LoadMail("Alice"); len(other) != 0 {
+		t.Errorf("Expected no mail for Alice, got %d", len(other))
+	}
+
+	if err := storage.SaveMail("Bob", nil); err != nil {
+		t.Fatalf("Failed to clear mail: %v", err)
+	}
+	if loaded, _ := storage.LoadMail("Bob"); len(loaded) != 0 {
+		t.Errorf("Expected no mail after clearing, got %d", len(loaded))
+	}
+}
@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// ParseMessages parses the combat messages file and returns the message
+// sets for each attack type, in file order.
+//
+// Each set starts with a line holding "M" followed by a line with the
+// attack type and twelve "~" terminated messages: the kill, miss, hit and
+// immortal messages, each to the attacker, the victim and the room. An
+// attack type may have several sets. The file ends with a line holding "$".
+func ParseMessages(filename string) (map[int][]*types.CombatMessage, error) {
+	parser, err := NewParser(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer parser.Close()
+
+	messages := make(map[int][]*types.CombatMessage)
+	for parser.NextLine() {
+		line := strings.TrimSpace(parser.Line())
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "$") {
+			break
+		}
+		if line != "M" {
+			return nil, fmt.Errorf("expected M on line %d, got %q", parser.LineNum(), line)
+		}
+
+		if !parser.NextLine() {
+			return nil, fmt.Errorf("unexpected end of file on line %d while reading attack type", parser.LineNum())
+		}
+		attackType, err := strconv.Atoi(strings.TrimSpace(parser.Line()))
+		if err != nil {
+			return nil, fmt.Errorf("invalid attack type on line %d: %w", parser.LineNum(), err)
+		}
+
+		msg := &types.CombatMessage{}
+		for _, triple := range []*types.MessageTriple{&msg.Die, &msg.Miss, &msg.Hit, &msg.God} {
+			for _, field := range []*string{&triple.Attacker, &triple.Victim, &triple.Room} {
+				if !parser.NextLine() {
+					return nil, fmt.Errorf("unexpected end of file on line %d while reading message", parser.LineNum())
+				}
+				*field = readString(parser)
+			}
+		}
+
+		messages[attackType] = append(messages[attackType], msg)
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"testing"
+)
+
+func TestParseMessages(t *testing.T) {
+	messages, err := ParseMessages("../../lib/messages")
+	if err != nil {
+		t.Fatalf("Failed to parse lib/messages: %v", err)
+	}
+
+	count := 0
+	for _, sets := range messages {
+		count += len(sets)
+	}
+	if count != 29 {
+		t.Errorf("Expected 29 message sets, got %d", count)
+	}
+
+	burning := messages[5]
+	if len(burning) != 1 {
+		t.Fatalf("Expected 1 burning hands message set, got %d", len(burning))
+	}
+	if burning[0].Die.Attacker != "You burned $N to death" || burning[0].God.Room != "Unaware of the risks, $n tries to burn $N" {
+		t.Errorf("Unexpected burning hands messages: %q / %q", burning[0].Die.Attacker, burning[0].God.Room)
+	}
+
+	if len(messages[102]) != 2 {
+		t.Errorf("Expected 2 pierce message sets, got %d", len(messages[102]))
+	}
+
+	suffering := messages[200]
+	if len(suffering) != 1 || suffering[0].Die.Attacker != "" ||
+		suffering[0].Die.Victim != "You can only lie still as the last heartbeat ebbs..." {
+		t.Errorf("Unexpected suffering messages: %+v", suffering)
+	}
+}
@@ -265,6 +265,13 @@ func ParseObjects(filename string) ([]*types.Object, error) {
 			currentObject.Weight = weight
 			currentObject.Cost = cost
 
+			// The rent per day is optional
+			if len(weightParts) > 2 {
+				if rent, err := strconv.Atoi(weightParts[2]); err == nil {
+					currentObject.RentCost = rent
+				}
+			}
+
 			// We're done with this object, skip until we find a new one
 			skipUntilNextObject = true
 		}
@@ -87,3 +87,8 @@ func (p *Parser) ReadString(delimiter string) (string, error) {
 func (p *Parser) ReadInt() (int, error) {
 	return strconv.Atoi(strings.TrimSpace(p.Line()))
 }
+
+// Close closes the underlying file
+func (p *Parser) Close() error {
+	return p.file.Close()
+}
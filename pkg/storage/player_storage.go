@@ -44,27 +44,6 @@ func createSafeObjectCopy(original *types.ObjectInstance) *types.ObjectInstance
 	return safeCopy
 }
 
-// restoreObjectRelationships restores the circular references in objects after loading
-func restoreObjectRelationships(player *types.Character) {
-	// Restore equipment relationships
-	for i, item := range player.Equipment {
-		if item != nil {
-			item.CarriedBy = player
-			item.WornBy = player
-			item.WornOn = i
-			restoreContainerRelationships(item)
-		}
-	}
-
-	// Restore inventory relationships
-	for _, item := range player.Inventory {
-		if item != nil {
-			item.CarriedBy = player
-			restoreContainerRelationships(item)
-		}
-	}
-}
-
 // restoreContainerRelationships recursively restores container relationships
 func restoreContainerRelationships(container *types.ObjectInstance) {
 	for _, containedItem := range container.Contains {
@@ -126,21 +105,6 @@ func (s *FilePlayerStorage) SavePlayer(player *types.Character) error {
 		player.RoomVNUM = player.InRoom.VNUM
 	}
 
-	// Create serializable copies of equipment and inventory without circular references
-	safeEquipment := make([]*types.ObjectInstance, len(player.Equipment))
-	for i, item := range player.Equipment {
-		if item != nil {
-			safeEquipment[i] = createSafeObjectCopy(item)
-		}
-	}
-
-	safeInventory := make([]*types.ObjectInstance, len(player.Inventory))
-	for i, item := range player.Inventory {
-		if item != nil {
-			safeInventory[i] = createSafeObjectCopy(item)
-		}
-	}
-
 	// Create a serializable version of the player without transient fields
 	// We can't copy the struct directly due to the mutex, so we'll create a new one
 	playerData := types.Character{
@@ -155,6 +119,8 @@ func (s *FilePlayerStorage) SavePlayer(player *types.Character) error {
 		Position:      types.POS_STANDING, // Always save as standing (will be reset on login anyway)
 		Gold:          player.Gold,
 		Experience:    player.Experience,
+		Practices:     player.Practices,
+		Wimpy:         player.Wimpy,
 		Alignment:     player.Alignment,
 		HP:            player.HP,
 		MaxHitPoints:  player.MaxHitPoints,
@@ -172,8 +138,8 @@ func (s *FilePlayerStorage) SavePlayer(player *types.Character) error {
 		Conditions:    player.Conditions,
 		Skills:        player.Skills,
 		Spells:        player.Spells,
-		Equipment:     safeEquipment, // Use safe copies without circular references
-		Inventory:     safeInventory, // Use safe copies without circular references
+		Equipment:     make([]*types.ObjectInstance, types.NUM_WEARS), // Objects are kept in the rent file,
+		Inventory:     make([]*types.ObjectInstance, 0),               // never in the player file
 		RoomVNUM:      player.RoomVNUM,
 		// Transient fields are excluded:
 		// InRoom, Fighting, World, Following, Followers, etc.
@@ -183,6 +149,8 @@ func (s *FilePlayerStorage) SavePlayer(player *types.Character) error {
 		Prototype:     player.Prototype,
 		Functions:     player.Functions,
 		LastLogin:     player.LastLogin,
+		RentTime:      player.RentTime,
+		RentCost:      player.RentCost,
 		Password:      player.Password,
 		Title:         player.Title,
 		Prompt:        player.Prompt,
@@ -228,8 +196,10 @@ func (s *FilePlayerStorage) LoadPlayer(name string) (*types.Character, error) {
 		return nil, fmt.Errorf("failed to unmarshal player: %w", err)
 	}
 
-	// Restore object relationships after loading
-	restoreObjectRelationships(&player)
+	// Objects only come back from the rent file; any an older player file
+	// still holds are not handed out for free
+	player.Equipment = make([]*types.ObjectInstance, types.NUM_WEARS)
+	player.Inventory = make([]*types.ObjectInstance, 0)
 
 	// Note: The InRoom field will be set by the World.AddCharacter method
 	// based on the RoomVNUM value
@@ -263,6 +233,14 @@ func (s *FilePlayerStorage) DeletePlayer(name string) error {
 		return fmt.Errorf("failed to delete player file: %w", err)
 	}
 
+	// Anything left with the receptionist or the postmaster goes too
+	if err := s.SaveObjects(name, nil); err != nil {
+		return err
+	}
+	if err := s.SaveMail(name, nil); err != nil {
+		return err
+	}
+
 	return nil
 }
 
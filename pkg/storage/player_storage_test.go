@@ -106,55 +106,13 @@ func TestSavePlayerWithCircularReferences(t *testing.T) {
 		t.Errorf("Expected gold 100, got %d", loadedPlayer.Gold)
 	}
 
-	// Verify equipment was restored
-	if loadedPlayer.Equipment[types.WEAR_WIELD] == nil {
-		t.Error("Expected sword in WEAR_WIELD slot, got nil")
-	} else {
-		loadedSword := loadedPlayer.Equipment[types.WEAR_WIELD]
-		if loadedSword.Prototype.Name != "sword" {
-			t.Errorf("Expected sword name 'sword', got '%s'", loadedSword.Prototype.Name)
-		}
-		// Verify circular references were restored
-		if loadedSword.CarriedBy != loadedPlayer {
-			t.Error("Expected sword.CarriedBy to point to player")
-		}
-		if loadedSword.WornBy != loadedPlayer {
-			t.Error("Expected sword.WornBy to point to player")
-		}
-		if loadedSword.WornOn != types.WEAR_WIELD {
-			t.Errorf("Expected sword.WornOn to be %d, got %d", types.WEAR_WIELD, loadedSword.WornOn)
-		}
-	}
-
-	// Verify inventory was restored
-	if len(loadedPlayer.Inventory) != 1 {
-		t.Errorf("Expected 1 item in inventory, got %d", len(loadedPlayer.Inventory))
-	} else {
-		loadedBag := loadedPlayer.Inventory[0]
-		if loadedBag.Prototype.Name != "bag" {
-			t.Errorf("Expected bag name 'bag', got '%s'", loadedBag.Prototype.Name)
-		}
-		// Verify circular references were restored
-		if loadedBag.CarriedBy != loadedPlayer {
-			t.Error("Expected bag.CarriedBy to point to player")
-		}
-
-		// Verify container contents were restored
-		if len(loadedBag.Contains) != 1 {
-			t.Errorf("Expected 1 item in bag, got %d", len(loadedBag.Contains))
-		} else {
-			loadedPotion := loadedBag.Contains[0]
-			if loadedPotion.Prototype.Name != "potion" {
-				t.Errorf("Expected potion name 'potion', got '%s'", loadedPotion.Prototype.Name)
-			}
-			// Verify circular references were restored
-			if loadedPotion.InObj != loadedBag {
-				t.Error("Expected potion.InObj to point to bag")
-			}
-			if loadedPotion.CarriedBy != nil {
-				t.Error("Expected potion.CarriedBy to be nil (items in containers are not carried directly)")
-			}
-		}
+	// Objects only persist through the rent file, so the player file
+	// doesn't hand them back
+	if len(loadedPlayer.Equipment) != types.NUM_WEARS || loadedPlayer.Equipment[types.WEAR_WIELD] != nil {
+		t.Error("Expected no sword to come back from the player file")
+	}
+	if len(loadedPlayer.Inventory) != 0 {
+		t.Errorf("Expected no inventory to come back from the player file, got %d items", len(loadedPlayer.Inventory))
 	}
 }
 
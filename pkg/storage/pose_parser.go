@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// ParsePoses parses the poses file and returns the pose tiers in file order.
+//
+// Each tier starts with its minimum level, followed by a message to the poser
+// and a message to the room for each class in the order magic user, cleric,
+// thief, warrior, with "#" standing for an empty message. The file ends with
+// a line containing -1.
+func ParsePoses(filename string) ([]*types.Pose, error) {
+	parser, err := NewParser(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer parser.Close()
+
+	var poses []*types.Pose
+	for parser.NextLine() {
+		line := strings.TrimSpace(parser.Line())
+		if line == "" {
+			continue
+		}
+
+		level, err := strconv.Atoi(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pose level on line %d: %w", parser.LineNum(), err)
+		}
+		if level < 0 {
+			break
+		}
+
+		pose := &types.Pose{Level: level}
+		for class := 0; class < len(pose.CharMsg); class++ {
+			if pose.CharMsg[class], err = readActionMessage(parser); err != nil {
+				return nil, err
+			}
+			if pose.RoomMsg[class], err = readActionMessage(parser); err != nil {
+				return nil, err
+			}
+		}
+
+		poses = append(poses, pose)
+	}
+
+	return poses, nil
+}
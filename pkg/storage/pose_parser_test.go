@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"testing"
+)
+
+func TestParsePoses(t *testing.T) {
+	poses, err := ParsePoses("../../lib/poses")
+	if err != nil {
+		t.Fatalf("Failed to parse lib/poses: %v", err)
+	}
+
+	if len(poses) != 18 {
+		t.Fatalf("Expected 18 pose tiers, got %d", len(poses))
+	}
+
+	first := poses[0]
+	if first.Level != 5 {
+		t.Errorf("Expected first tier to be level 5, got %d", first.Level)
+	}
+	if first.CharMsg[0] != "You sizzle with energy." || first.RoomMsg[0] != "$n sizzles with energy." {
+		t.Errorf("Unexpected magic user messages: %q / %q", first.CharMsg[0], first.RoomMsg[0])
+	}
+	if first.CharMsg[3] != "You show your bulging muscles." || first.RoomMsg[3] != "$n shows $s bulging muscles." {
+		t.Errorf("Unexpected warrior messages: %q / %q", first.CharMsg[3], first.RoomMsg[3])
+	}
+
+	last := poses[len(poses)-1]
+	if last.Level != 22 {
+		t.Errorf("Expected last tier to be level 22, got %d", last.Level)
+	}
+	if last.RoomMsg[3] != "$n sends the whole world spinning." {
+		t.Errorf("Unexpected last warrior room message: %q", last.RoomMsg[3])
+	}
+}
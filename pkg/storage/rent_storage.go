@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// SaveObjects saves the objects a player left with the receptionist. Saving
+// no objects removes the rent file.
+func (s *FilePlayerStorage) SaveObjects(name string, objects []*types.ObjectInstance) error {
+	filePath := s.getRentFilePath(name)
+
+	if len(objects) == 0 {
+		if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove rent file: %w", err)
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return fmt.Errorf("failed to create rent directory: %w", err)
+	}
+
+	// Create serializable copies without circular references
+	safeObjects := make([]*types.ObjectInstance, 0, len(objects))
+	for _, obj := range objects {
+		if obj != nil {
+			safeObjects = append(safeObjects, createSafeObjectCopy(obj))
+		}
+	}
+
+	data, err := json.MarshalIndent(safeObjects, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal objects: %w", err)
+	}
+
+	if err := os.WriteFile(filePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write rent file: %w", err)
+	}
+
+	return nil
+}
+
+// LoadObjects loads the objects a player left with the receptionist. A
+// player without a rent file has no objects.
+func (s *FilePlayerStorage) LoadObjects(name string) ([]*types.ObjectInstance, error) {
+	data, err := os.ReadFile(s.getRentFilePath(name))
+	if os.IsNotExist(err) {
+		return []*types.ObjectInstance{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rent file: %w", err)
+	}
+
+	var objects []*types.ObjectInstance
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal objects: %w", err)
+	}
+
+	for _, obj := range objects {
+		restoreContainerRelationships(obj)
+	}
+
+	return objects, nil
+}
+
+// getRentFilePath returns the rent file path for a player. Rent files live in
+// their own directory so they are not mistaken for player files.
+func (s *FilePlayerStorage) getRentFilePath(name string) string {
+	return filepath.Join(s.playerDir, "rent", strings.ToLower(name)+".json")
+}
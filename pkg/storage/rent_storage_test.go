@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// TestRentObjectsRoundTrip tests that rented objects survive a save and load
+// and that the rent file is not mistaken for a player
+func TestRentObjectsRoundTrip(t *testing.T) {
+	storage, err := NewFilePlayerStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create storage: %v", err)
+	}
+
+	player := &types.Character{Name: "Renter"}
+	bag := &types.ObjectInstance{
+		Prototype: &types.Object{VNUM: 1002, Name: "bag", Type: types.ITEM_CONTAINER},
+		CarriedBy: player,
+		WornOn:    -1,
+	}
+	potion := &types.ObjectInstance{
+		Prototype: &types.Object{VNUM: 1003, Name: "potion", Type: types.ITEM_POTION},
+		InObj:     bag,
+	}
+	bag.Contains = []*types.ObjectInstance{potion}
+	sword := &types.ObjectInstance{
+		Prototype: &types.Object{VNUM: 1001, Name: "sword", Type: types.ITEM_WEAPON},
+		WornBy:    player,
+		WornOn:    types.WEAR_WIELD,
+	}
+
+	if err := storage.SaveObjects(player.Name, []*types.ObjectInstance{bag, sword}); err != nil {
+		t.Fatalf("Failed to save objects: %v", err)
+	}
+
+	players, err := storage.ListPlayers()
+	if err != nil {
+		t.Fatalf("Failed to list players: %v", err)
+	}
+	if len(players) != 0 {
+		t.Errorf("Expected no players, got %v", players)
+	}
+
+	objects, err := storage.LoadObjects("renter")
+	if err != nil {
+		t.Fatalf("Failed to load objects: %v", err)
+	}
+	if len(objects) != 2 {
+		t.Fatalf("Expected 2 objects, got %d", len(objects))
+	}
+	if objects[0].Prototype.VNUM != 1002 || objects[0].WornOn != -1 {
+		t.Errorf("Expected the bag from the inventory, got %d on %d", objects[0].Prototype.VNUM, objects[0].WornOn)
+	}
+	if len(objects[0].Contains) != 1 || objects[0].Contains[0].InObj != objects[0] {
+		t.Error("Expected the potion to be restored inside the bag")
+	}
+	if objects[1].WornOn != types.WEAR_WIELD {
+		t.Errorf("Expected the sword to be wielded, got %d", objects[1].WornOn)
+	}
+
+	// Saving nothing clears the rent file
+	if err := storage.SaveObjects(player.Name, nil); err != nil {
+		t.Fatalf("Failed to clear objects: %v", err)
+	}
+	objects, err = storage.LoadObjects(player.Name)
+	if err != nil {
+		t.Fatalf("Failed to load objects: %v", err)
+	}
+	if len(objects) != 0 {
+		t.Errorf("Expected no objects after clearing, got %d", len(objects))
+	}
+}
@@ -0,0 +1,145 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// socialCommandNames maps the original DikuMUD command numbers used in the
+// actions file to the name of the social
+var socialCommandNames = map[int]string{
+	9: "kiss", 22: "bounce", 23: "smile", 24: "dance", 26: "cackle",
+	27: "laugh", 28: "giggle", 29: "shake", 30: "puke", 31: "growl",
+	32: "scream", 33: "insult", 34: "comfort", 35: "nod", 36: "sigh",
+	37: "sulk", 49: "hug", 50: "snuggle", 51: "cuddle", 52: "nuzzle",
+	53: "cry", 94: "poke", 96: "accuse", 97: "grin", 98: "bow",
+	104: "applaud", 105: "blush", 106: "burp", 107: "chuckle", 108: "clap",
+	109: "cough", 110: "curtsey", 111: "fart", 112: "flip", 113: "fondle",
+	114: "frown", 115: "gasp", 116: "glare", 117: "groan", 118: "grope",
+	119: "hiccup", 120: "lick", 121: "love", 122: "moan", 123: "nibble",
+	124: "pout", 125: "purr", 126: "ruffle", 127: "shiver", 128: "shrug",
+	129: "sing", 130: "slap", 131: "smirk", 132: "snap", 133: "sneeze",
+	134: "snicker", 135: "sniff", 136: "snore", 137: "spit", 138: "squeeze",
+	139: "stare", 140: "strut", 141: "thank", 142: "twiddle", 143: "wave",
+	144: "whistle", 145: "wiggle", 146: "wink", 147: "yawn", 148: "snowball",
+	160: "french", 161: "comb", 162: "massage", 163: "tickle", 165: "pat",
+	171: "curse", 176: "pray", 178: "beg", 179: "bleed", 180: "cringe",
+	181: "daydream", 182: "fume", 183: "grovel", 184: "hop", 185: "nudge",
+	186: "peer", 187: "point", 188: "ponder", 189: "punch", 190: "snarl",
+	191: "spank", 192: "steam", 193: "tackle", 194: "taunt", 195: "think",
+	196: "whine", 197: "worship", 198: "yodel",
+}
+
+// ParseSocials parses the actions file and returns a slice of socials.
+//
+// Each entry starts with a "<number> <hide> [<min victim position>]" line,
+// optionally followed by the social's name. Entries without a name use the
+// original DikuMUD command number to look it up. The message lines follow
+// in order, with "#" standing for an empty message. If the message to the
+// actor when a target is found is empty, the social takes no target and the
+// remaining messages are omitted. The file ends with a line containing -1.
+func ParseSocials(filename string) ([]*types.Social, error) {
+	parser, err := NewParser(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer parser.Close()
+
+	var socials []*types.Social
+	for parser.NextLine() {
+		line := strings.TrimSpace(parser.Line())
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		number, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid social number on line %d: %w", parser.LineNum(), err)
+		}
+		if number < 0 {
+			break
+		}
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("invalid social header on line %d: %s", parser.LineNum(), line)
+		}
+
+		hide, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid hide flag on line %d: %w", parser.LineNum(), err)
+		}
+
+		// Some entries in the original file leave out the minimum position
+		minPos := 0
+		if len(fields) > 2 {
+			if minPos, err = strconv.Atoi(fields[2]); err != nil {
+				return nil, fmt.Errorf("invalid minimum position on line %d: %w", parser.LineNum(), err)
+			}
+		}
+
+		social := &types.Social{
+			Number:            number,
+			Hide:              hide != 0,
+			MinVictimPosition: minPos,
+		}
+		if len(fields) > 3 {
+			social.Name = strings.ToLower(fields[3])
+		} else {
+			social.Name = socialCommandNames[number]
+		}
+
+		// Read the messages
+		if social.CharNoArg, err = readActionMessage(parser); err != nil {
+			return nil, err
+		}
+		if social.OthersNoArg, err = readActionMessage(parser); err != nil {
+			return nil, err
+		}
+		if social.CharFound, err = readActionMessage(parser); err != nil {
+			return nil, err
+		}
+
+		// Socials without a found message take no target
+		if social.CharFound != "" {
+			messages := []*string{
+				&social.OthersFound,
+				&social.VictFound,
+				&social.NotFound,
+				&social.CharAuto,
+				&social.OthersAuto,
+			}
+			for _, msg := range messages {
+				if *msg, err = readActionMessage(parser); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if social.Name == "" {
+			log.Printf("Warning: social %d on line %d has no name, skipping", number, parser.LineNum())
+			continue
+		}
+
+		socials = append(socials, social)
+	}
+
+	return socials, nil
+}
+
+// readActionMessage reads a single social or pose message line, where "#"
+// means there is no message
+func readActionMessage(parser *Parser) (string, error) {
+	if !parser.NextLine() {
+		return "", fmt.Errorf("unexpected end of file on line %d while reading message", parser.LineNum())
+	}
+
+	line := strings.TrimRight(parser.Line(), " \t\r")
+	if strings.HasPrefix(line, "#") {
+		return "", nil
+	}
+	return line, nil
+}
@@ -0,0 +1,119 @@
+package storage
+
+import (
+	"os"
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+func TestParseSocials(t *testing.T) {
+	socialData := `9 0 0
+Isn't there someone you want to kiss?
+#
+You kiss $M.
+$n kisses $N.
+$n kisses you.
+Never around when required.
+All the lonely people :(
+#
+
+22 0 0
+BOIINNNNNNGG!
+$n bounces around.
+#
+
+500 1 5 highfive
+You wave your hand in the air.
+$n waves $s hand in the air.
+You give $M a high five.
+$n gives $N a high five.
+$n gives you a high five.
+Nobody here by that name.
+You clap your own hands.
+$n claps $s own hands.
+
+501 0 0
+Nobody knows my name.
+#
+#
+
+-1
+`
+
+	tmpFile, err := os.CreateTemp("", "actions_test*")
+	if err != nil {
+		t.Fatalf("Failed to create temp file: %v", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.WriteString(socialData); err != nil {
+		t.Fatalf("Failed to write temp file: %v", err)
+	}
+	tmpFile.Close()
+
+	socials, err := ParseSocials(tmpFile.Name())
+	if err != nil {
+		t.Fatalf("Failed to parse socials: %v", err)
+	}
+
+	// The unnamed, unknown social 501 is skipped
+	if len(socials) != 3 {
+		t.Fatalf("Expected 3 socials, got %d", len(socials))
+	}
+
+	kiss := socials[0]
+	if kiss.Name != "kiss" || kiss.Hide || kiss.MinVictimPosition != 0 {
+		t.Errorf("Unexpected kiss header: %+v", kiss)
+	}
+	if kiss.OthersNoArg != "" {
+		t.Errorf("Expected empty others message for kiss, got %q", kiss.OthersNoArg)
+	}
+	if kiss.CharFound != "You kiss $M." || kiss.CharAuto != "All the lonely people :(" {
+		t.Errorf("Unexpected kiss messages: %+v", kiss)
+	}
+	if kiss.OthersAuto != "" {
+		t.Errorf("Expected empty auto room message for kiss, got %q", kiss.OthersAuto)
+	}
+
+	bounce := socials[1]
+	if bounce.Name != "bounce" || bounce.TakesTarget() {
+		t.Errorf("Expected bounce to take no target: %+v", bounce)
+	}
+	if bounce.OthersNoArg != "$n bounces around." {
+		t.Errorf("Unexpected bounce room message: %q", bounce.OthersNoArg)
+	}
+
+	highfive := socials[2]
+	if highfive.Name != "highfive" || !highfive.Hide || highfive.MinVictimPosition != types.POS_SLEEPING+1 {
+		t.Errorf("Unexpected highfive header: %+v", highfive)
+	}
+	if highfive.NotFound != "Nobody here by that name." || highfive.OthersAuto != "$n claps $s own hands." {
+		t.Errorf("Unexpected highfive messages: %+v", highfive)
+	}
+}
+
+func TestParseSocialsLibFile(t *testing.T) {
+	socials, err := ParseSocials("../../lib/actions")
+	if err != nil {
+		t.Fatalf("Failed to parse lib/actions: %v", err)
+	}
+
+	names := make(map[string]*types.Social)
+	for _, social := range socials {
+		if _, ok := names[social.Name]; ok {
+			t.Errorf("Duplicate social %s", social.Name)
+		}
+		names[social.Name] = social
+	}
+
+	for _, name := range []string{"kiss", "bounce", "smile", "dance", "grovel"} {
+		if _, ok := names[name]; !ok {
+			t.Errorf("Expected social %s to be loaded", name)
+		}
+	}
+
+	if dance := names["dance"]; dance != nil && dance.MinVictimPosition != types.POS_STANDING {
+		t.Errorf("Expected dance to require a standing target, got %d", dance.MinVictimPosition)
+	}
+}
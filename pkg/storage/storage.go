@@ -26,6 +26,10 @@ type Storage interface {
 	// Player object data (rent system)
 	LoadPlayerObjects(name string) ([]*types.ObjectInstance, error)
 	SavePlayerObjects(name string, objects []*types.ObjectInstance) error
+
+	// Player mail data (post office)
+	LoadPlayerMail(name string) ([]*types.MailMessage, error)
+	SavePlayerMail(name string, mail []*types.MailMessage) error
 }
 
 // NewStorage creates a new storage instance based on configuration
@@ -0,0 +1,17 @@
+package types
+
+import "time"
+
+// MAX_BOARD_MESSAGES is the most messages a bulletin board holds
+const MAX_BOARD_MESSAGES = 50
+
+// MAX_MESSAGE_LENGTH is the longest text the editor accepts
+const MAX_MESSAGE_LENGTH = 2048
+
+// BoardMessage represents one message posted on a bulletin board
+type BoardMessage struct {
+	Author string
+	Title  string
+	Text   string
+	Posted time.Time
+}
@@ -0,0 +1,44 @@
+package types
+
+// strCarryWeight is the weight a character can carry for each strength
+// score, from str_app in the original DikuMUD
+var strCarryWeight = [26]int{
+	0, 3, 3, 10, 25, 55, 80, 90, 100, 100, // 0-9
+	115, 115, 140, 140, 170, 170, 195, 220, 255, 640, // 10-19
+	700, 810, 970, 1130, 1440, 1750, // 20-25
+}
+
+// CanCarryWeight returns the total weight a character can carry
+func CanCarryWeight(ch *Character) int {
+	str := max(0, min(len(strCarryWeight)-1, ch.Abilities[ABILITY_STR]))
+	return strCarryWeight[str]
+}
+
+// CanCarryNumber returns the number of items a character can carry
+func CanCarryNumber(ch *Character) int {
+	return 5 + ch.Abilities[ABILITY_DEX]/2 + ch.Level/2
+}
+
+// CarryingWeight returns the weight of everything in a character's
+// inventory, including the contents of containers
+func CarryingWeight(ch *Character) int {
+	weight := 0
+	for _, obj := range ch.Inventory {
+		weight += ObjectWeight(obj)
+	}
+	return weight
+}
+
+// CarryingNumber returns the number of items in a character's inventory
+func CarryingNumber(ch *Character) int {
+	return len(ch.Inventory)
+}
+
+// ObjectWeight returns the weight of an object and everything inside it
+func ObjectWeight(obj *ObjectInstance) int {
+	weight := obj.Prototype.Weight
+	for _, content := range obj.Contains {
+		weight += ObjectWeight(content)
+	}
+	return weight
+}
@@ -14,6 +14,8 @@ type ClassInfo struct {
 	StartingMove int     // Starting movement points
 	StartingGold int     // Starting gold
 	Alignment   int      // Starting alignment tendency
+	MaxLearn    int      // Highest percentage a guildmaster can teach
+	LearnAbility int     // Ability that sets how fast the class learns (ABILITY_INT or ABILITY_WIS)
 }
 
 // ClassData contains information about all character classes
@@ -31,6 +33,8 @@ var ClassData = map[int]*ClassInfo{
 		StartingMove: 100,
 		StartingGold: 100,
 		Alignment:   0, // Neutral tendency
+		MaxLearn:    95,
+		LearnAbility: ABILITY_INT,
 	},
 	CLASS_CLERIC: {
 		Name:        "Cleric",
@@ -45,6 +49,8 @@ var ClassData = map[int]*ClassInfo{
 		StartingMove: 100,
 		StartingGold: 150,
 		Alignment:   350, // Good tendency
+		MaxLearn:    95,
+		LearnAbility: ABILITY_WIS,
 	},
 	CLASS_THIEF: {
 		Name:        "Thief",
@@ -59,6 +65,8 @@ var ClassData = map[int]*ClassInfo{
 		StartingMove: 120,
 		StartingGold: 250,
 		Alignment:   -350, // Evil tendency
+		MaxLearn:    85,
+		LearnAbility: ABILITY_INT,
 	},
 	CLASS_WARRIOR: {
 		Name:        "Warrior",
@@ -73,6 +81,8 @@ var ClassData = map[int]*ClassInfo{
 		StartingMove: 110,
 		StartingGold: 200,
 		Alignment:   0, // Neutral tendency
+		MaxLearn:    80,
+		LearnAbility: ABILITY_WIS,
 	},
 }
 
@@ -159,6 +169,40 @@ func CanWearItem(class int, itemType, itemFlags int) bool {
 	return true
 }
 
+// GetClassMaxLearn returns the highest percentage a guildmaster can teach a class
+func GetClassMaxLearn(class int) int {
+	if info, ok := ClassData[class]; ok {
+		return info.MaxLearn
+	}
+	return 75
+}
+
+// GetClassLearnAbility returns the ability that sets how fast a class learns
+func GetClassLearnAbility(class int) int {
+	if info, ok := ClassData[class]; ok {
+		return info.LearnAbility
+	}
+	return ABILITY_INT
+}
+
+// learnRates is the percentage gained per practice session for an ability
+// score, following int_app in the original DikuMUD
+var learnRates = [26]int{
+	3, 5, 7, 8, 9, 10, 11, 12, 13, 15, 17, 19, 22,
+	25, 30, 35, 40, 45, 50, 53, 55, 56, 57, 58, 59, 60,
+}
+
+// GetLearnRate returns the percentage gained per practice session for an
+// ability score
+func GetLearnRate(score int) int {
+	if score < 0 {
+		score = 0
+	} else if score >= len(learnRates) {
+		score = len(learnRates) - 1
+	}
+	return learnRates[score]
+}
+
 // GetClassHitDie returns the hit die for a class
 func GetClassHitDie(class int) int {
 	if info, ok := ClassData[class]; ok {
@@ -182,3 +226,161 @@ func GetClassMoveDie(class int) int {
 	}
 	return 2
 }
+
+// LEVEL_IMMORTAL is the first immortal level; experience alone never
+// advances a mortal to it
+const LEVEL_IMMORTAL = 21
+
+// MAX_EXP_GAIN and MAX_EXP_LOSS cap the experience a single gain can add or
+// take away
+const (
+	MAX_EXP_GAIN = 100000
+	MAX_EXP_LOSS = 500000
+)
+
+// ClassTitle is one row of a class's experience/title table
+type ClassTitle struct {
+	MaleTitle   string // Title for male (and neutral) characters
+	FemaleTitle string // Title for female characters
+	Exp         int    // Experience needed to reach the level
+}
+
+// ClassTitles contains the experience/title table for each class, indexed
+// by level (0 through 24)
+var ClassTitles = map[int][]ClassTitle{
+	CLASS_MAGIC_USER: {
+		{"the Man", "the Woman", 0},
+		{"the Apprentice of Magic", "the Apprentice of Magic", 1},
+		{"the Spell Student", "the Spell Student", 2500},
+		{"the Scholar of Magic", "the Scholar of Magic", 5000},
+		{"the Delver in Spells", "the Delveress in Spells", 10000},
+		{"the Medium of Magic", "the Medium of Magic", 20000},
+		{"the Scribe of Magic", "the Scribess of Magic", 40000},
+		{"the Seer", "the Seeress", 60000},
+		{"the Sage", "the Sage", 90000},
+		{"the Illusionist", "the Illusionist", 135000},
+		{"the Abjurer", "the Abjuress", 250000},
+		{"the Invoker", "the Invoker", 375000},
+		{"the Enchanter", "the Enchantress", 750000},
+		{"the Conjurer", "the Conjuress", 1125000},
+		{"the Magician", "the Witch", 1500000},
+		{"the Creator", "the Creator", 1875000},
+		{"the Savant", "the Savant", 2250000},
+		{"the Magus", "the Craftess", 2625000},
+		{"the Wizard", "the Wizard", 3000000},
+		{"the Warlock", "the War Witch", 3375000},
+		{"the Sorcerer", "the Sorceress", 3750000},
+		{"the Immortal Warlock", "the Immortal Enchantress", 4000000},
+		{"the Avatar of Magic", "the Empress of Magic", 5000000},
+		{"the God of magic", "the Goddess of magic", 6000000},
+		{"the Implementator", "the Implementress", 7000000},
+	},
+	CLASS_CLERIC: {
+		{"the Man", "the Woman", 0},
+		{"the Believer", "the Believer", 1},
+		{"the Attendant", "the Attendant", 1500},
+		{"the Acolyte", "the Acolyte", 3000},
+		{"the Novice", "the Novice", 6000},
+		{"the Missionary", "the Missionary", 13000},
+		{"the Adept", "the Adept", 27500},
+		{"the Deacon", "the Deaconess", 55000},
+		{"the Vicar", "the Vicaress", 110000},
+		{"the Priest", "the Priestess", 225000},
+		{"the Minister", "the Lady Minister", 450000},
+		{"the Canon", "the Canon", 675000},
+		{"the Levite", "the Levitess", 900000},
+		{"the Curate", "the Curess", 1125000},
+		{"the Monk", "the Nunne", 1350000},
+		{"the Healer", "the Healess", 1575000},
+		{"the Chaplain", "the Chaplain", 1800000},
+		{"the Expositor", "the Expositress", 2025000},
+		{"the Bishop", "the Bishop", 2250000},
+		{"the Arch Bishop", "the Arch Lady of the Church", 2475000},
+		{"the Patriarch", "the Matriarch", 2700000},
+		{"the Immortal Cardinal", "the Immortal Priestess", 3000000},
+		{"the Inquisitor", "the Inquisitress", 5000000},
+		{"the God of good and evil", "the Goddess of good and evil", 6000000},
+		{"the Implementator", "the Implementress", 7000000},
+	},
+	CLASS_THIEF: {
+		{"the Man", "the Woman", 0},
+		{"the Pilferer", "the Pilferess", 1},
+		{"the Footpad", "the Footpad", 1250},
+		{"the Filcher", "the Filcheress", 2500},
+		{"the Pick-Pocket", "the Pick-Pocket", 5000},
+		{"the Sneak", "the Sneak", 10000},
+		{"the Pincher", "the Pincheress", 20000},
+		{"the Cut-Purse", "the Cut-Purse", 30000},
+		{"the Snatcher", "the Snatcheress", 70000},
+		{"the Sharper", "the Sharpress", 110000},
+		{"the Rogue", "the Rogue", 160000},
+		{"the Robber", "the Robber", 220000},
+		{"the Magsman", "the Magswoman", 440000},
+		{"the Highwayman", "the Highwaywoman", 660000},
+		{"the Burglar", "the Burglaress", 880000},
+		{"the Thief", "the Thief", 1100000},
+		{"the Knifer", "the Knifer", 1500000},
+		{"the Quick-Blade", "the Quick-Blade", 2000000},
+		{"the Killer", "the Murderess", 2500000},
+		{"the Brigand", "the Brigand", 3000000},
+		{"the Cut-Throat", "the Cut-Throat", 3500000},
+		{"the Immortal Assasin", "the Immortal Assasin", 4000000},
+		{"the Demi God of thieves", "the Demi Goddess of thieves", 5000000},
+		{"the God of thieves and tradesmen", "the Goddess of thieves and tradesmen", 6000000},
+		{"the Implementator", "the Implementress", 7000000},
+	},
+	CLASS_WARRIOR: {
+		{"the Man", "the Woman", 0},
+		{"the Swordpupil", "the Swordpupil", 1},
+		{"the Recruit", "the Recruit", 2000},
+		{"the Sentry", "the Sentress", 4000},
+		{"the Fighter", "the Fighter", 8000},
+		{"the Soldier", "the Soldier", 16000},
+		{"the Warrior", "the Warrior", 32000},
+		{"the Veteran", "the Veteran", 64000},
+		{"the Swordsman", "the Swordswoman", 125000},
+		{"the Fencer", "the Fenceress", 250000},
+		{"the Combatant", "the Combatess", 500000},
+		{"the Hero", "the Heroine", 750000},
+		{"the Myrmidon", "the Myrmidon", 1000000},
+		{"the Swashbuckler", "the Swashbuckleress", 1250000},
+		{"the Mercenary", "the Mercenaress", 1500000},
+		{"the Swordmaster", "the Swordmistress", 1850000},
+		{"the Lieutenant", "the Lieutenant", 2200000},
+		{"the Champion", "the Lady Champion", 2550000},
+		{"the Dragoon", "the Lady Dragoon", 2900000},
+		{"the Cavalier", "the Cavalier", 3250000},
+		{"the Knight", "the Lady Knight", 3600000},
+		{"the Immortal Warlord", "the Immortal Lady of War", 4000000},
+		{"the Extirpator", "the Queen of Destruction", 5000000},
+		{"the God of war", "the Goddess of war", 6000000},
+		{"the Implementator", "the Implementress", 7000000},
+	},
+}
+
+// GetExpForLevel returns the experience a class needs to reach a level
+func GetExpForLevel(class, level int) int {
+	titles, ok := ClassTitles[class]
+	if !ok || level <= 0 {
+		return 0
+	}
+	if level >= len(titles) {
+		return titles[len(titles)-1].Exp
+	}
+	return titles[level].Exp
+}
+
+// GetClassTitle returns the title for a class, level and sex
+func GetClassTitle(class, level, sex int) string {
+	titles, ok := ClassTitles[class]
+	if !ok || level < 0 {
+		return ""
+	}
+	if level >= len(titles) {
+		level = len(titles) - 1
+	}
+	if sex == SEX_FEMALE {
+		return titles[level].FemaleTitle
+	}
+	return titles[level].MaleTitle
+}
@@ -0,0 +1,89 @@
+package types
+
+// MessageTriple is one message as the attacker, the victim and everyone
+// else in the room see it
+type MessageTriple struct {
+	Attacker string
+	Victim   string
+	Room     string
+}
+
+// CombatMessage is one set of messages for an attack type loaded from the
+// messages file. An attack type may have several sets to pick from.
+type CombatMessage struct {
+	Die  MessageTriple // The attack kills the victim
+	Miss MessageTriple // The attack does no damage
+	Hit  MessageTriple // The attack hurts the victim
+	God  MessageTriple // The attack is aimed at an immortal
+}
+
+// Attack types name what dealt some damage the way the messages file
+// numbers them: spells by their spell number, skills from 45, weapon types
+// from 100 and suffering at 200.
+const (
+	ATTACK_WEAPON_BASE = 100
+	ATTACK_SUFFERING   = 200
+)
+
+// skillAttackTypes maps skills to their attack types
+var skillAttackTypes = map[int]int{
+	SKILL_SNEAK:     45,
+	SKILL_HIDE:      46,
+	SKILL_STEAL:     47,
+	SKILL_BACKSTAB:  48,
+	SKILL_PICK_LOCK: 49,
+	SKILL_KICK:      50,
+	SKILL_BASH:      51,
+	SKILL_RESCUE:    52,
+}
+
+// spellAttackTypes maps the spells numbered differently in the messages
+// file to their attack types
+var spellAttackTypes = map[int]int{
+	SPELL_IDENTIFY:         53,
+	SPELL_FIRE_BREATH:      56,
+	SPELL_GAS_BREATH:       57,
+	SPELL_FROST_BREATH:     58,
+	SPELL_ACID_BREATH:      59,
+	SPELL_LIGHTNING_BREATH: 60,
+}
+
+// SpellAttackType returns the attack type of damage dealt by a spell
+func SpellAttackType(spell int) int {
+	if attackType, ok := spellAttackTypes[spell]; ok {
+		return attackType
+	}
+	return spell
+}
+
+// SkillAttackType returns the attack type of damage dealt by a skill, or
+// 0 for a skill that deals none
+func SkillAttackType(skill int) int {
+	return skillAttackTypes[skill]
+}
+
+// WeaponAttackType returns the attack type of damage dealt by a weapon of
+// the given TYPE_*
+func WeaponAttackType(weaponType int) int {
+	return ATTACK_WEAPON_BASE + weaponType
+}
+
+// WeaponType returns the TYPE_* of a weapon from the weapon type kept in its
+// fourth value, grouped the way Diku does: 0-2 whip, 3 slash, 4-6 crush,
+// 7 bludgeon and 8-11 pierce
+func WeaponType(value int) int {
+	switch value {
+	case 0, 1, 2:
+		return TYPE_WHIP
+	case 3:
+		return TYPE_SLASH
+	case 4, 5, 6:
+		return TYPE_CRUSH
+	case 7:
+		return TYPE_BLUDGEON
+	case 8, 9, 10, 11:
+		return TYPE_PIERCE
+	default:
+		return TYPE_HIT
+	}
+}
@@ -28,6 +28,13 @@ const (
 	ROOM_NOSUMMON
 )
 
+// Player flag constants (Character.Flags for players)
+const (
+	PLR_DEAF      = (1 << 0) // Does not hear shouts or yells
+	PLR_NOTELL    = (1 << 1) // Refuses tells
+	PLR_HOLYLIGHT = (1 << 2) // Immortal sees everything
+)
+
 // Direction constants
 const (
 	DIR_NORTH = 0
@@ -63,6 +70,9 @@ const (
 	AFF_FEAR             = (1 << 21)
 	AFF_CHARM            = (1 << 22)
 	AFF_FOLLOW           = (1 << 23)
+	AFF_INFRAVISION      = (1 << 24)
+	AFF_FLYING           = (1 << 25)
+	AFF_WATERBREATH      = (1 << 26)
 )
 
 // Apply constants (APPLY_XXX)
@@ -95,6 +105,14 @@ const (
 	APPLY_SAVING_SPELL  = 24
 )
 
+// ApplyNames are the names of the APPLY_* locations, indexed by location
+var ApplyNames = []string{
+	"NONE", "STR", "DEX", "INT", "WIS", "CON", "SEX", "CLASS", "LEVEL", "AGE",
+	"CHAR_WEIGHT", "CHAR_HEIGHT", "MANA", "HIT", "MOVE", "GOLD", "EXP", "ARMOR",
+	"HITROLL", "DAMROLL", "SAVING_PARA", "SAVING_ROD", "SAVING_PETRI", "SAVING_BREATH",
+	"SAVING_SPELL",
+}
+
 // Weapon type constants
 const (
 	TYPE_HIT      = 0 // Default for bare hands
@@ -183,6 +201,10 @@ const (
 	SECT_FLYING       = 9
 )
 
+// MovementLoss is the move points it takes to cross each sector type;
+// a step costs the average of the sectors left and entered
+var MovementLoss = [...]int{1, 2, 2, 3, 4, 6, 4, 1, 1, 10}
+
 // Liquid type constants
 const (
 	LIQ_WATER      = 0
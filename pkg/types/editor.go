@@ -0,0 +1,52 @@
+package types
+
+import "strings"
+
+// editor collects the lines of a text being written
+type editor struct {
+	text strings.Builder
+	max  int
+	done func(text string)
+}
+
+// StartEditor starts collecting the lines the character types, up to max
+// characters, until a line containing '@'. Then done is called with the
+// text written.
+func (c *Character) StartEditor(max int, done func(text string)) {
+	c.editor = &editor{max: max, done: done}
+}
+
+// IsEditing returns true if the character is writing a text
+func (c *Character) IsEditing() bool {
+	return c.editor != nil
+}
+
+// EditLine adds a line to the text being written. Everything from an '@'
+// on ends the text.
+func (c *Character) EditLine(line string) {
+	ed := c.editor
+	if ed == nil {
+		return
+	}
+
+	end := strings.IndexByte(line, '@')
+	if end >= 0 {
+		line = line[:end]
+	}
+
+	if ed.text.Len()+len(line)+2 > ed.max {
+		c.SendMessage("String too long - Truncated.\r\n")
+		line = line[:max(0, min(len(line), ed.max-ed.text.Len()-2))]
+		end = len(line)
+	}
+
+	if end < 0 || line != "" {
+		ed.text.WriteString(line)
+		ed.text.WriteString("\r\n")
+	}
+
+	if end >= 0 {
+		c.editor = nil
+		ed.done(ed.text.String())
+	}
+}
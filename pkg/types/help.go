@@ -0,0 +1,7 @@
+package types
+
+// HelpEntry represents one entry loaded from the help table
+type HelpEntry struct {
+	Keywords []string // Upper case keywords, multi-word keywords kept whole
+	Text     string   // The entry as shown to players, keyword line included
+}
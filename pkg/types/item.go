@@ -1,5 +1,7 @@
 package types
 
+import "strings"
+
 // Item type constants
 const (
 	ITEM_LIGHT      = 1
@@ -27,6 +29,41 @@ const (
 	ITEM_FOUNTAIN   = 23
 )
 
+// ItemTypeNames are the names identify gives the item types
+var ItemTypeNames = map[int]string{
+	ITEM_LIGHT:      "LIGHT",
+	ITEM_SCROLL:     "SCROLL",
+	ITEM_WAND:       "WAND",
+	ITEM_STAFF:      "STAFF",
+	ITEM_WEAPON:     "WEAPON",
+	ITEM_FIREWEAPON: "FIRE WEAPON",
+	ITEM_MISSILE:    "MISSILE",
+	ITEM_TREASURE:   "TREASURE",
+	ITEM_ARMOR:      "ARMOR",
+	ITEM_POTION:     "POTION",
+	ITEM_WORN:       "WORN",
+	ITEM_OTHER:      "OTHER",
+	ITEM_TRASH:      "TRASH",
+	ITEM_TRAP:       "TRAP",
+	ITEM_CONTAINER:  "CONTAINER",
+	ITEM_NOTE:       "NOTE",
+	ITEM_DRINKCON:   "LIQUID CONTAINER",
+	ITEM_KEY:        "KEY",
+	ITEM_FOOD:       "FOOD",
+	ITEM_MONEY:      "MONEY",
+	ITEM_PEN:        "PEN",
+	ITEM_BOAT:       "BOAT",
+	ITEM_FOUNTAIN:   "FOUNTAIN",
+}
+
+// GetItemTypeName returns the name of an item type
+func GetItemTypeName(itemType int) string {
+	if name, ok := ItemTypeNames[itemType]; ok {
+		return name
+	}
+	return "UNDEFINED"
+}
+
 // Item extra flag constants
 const (
 	ITEM_GLOW         = (1 << 0)
@@ -49,6 +86,27 @@ const (
 	ITEM_NOPICK       = (1 << 17)
 )
 
+// ExtraFlagNames are the names of the item extra flags, in bit order
+var ExtraFlagNames = []string{
+	"GLOW", "HUM", "NORENT", "NODONATE", "NOINVIS", "INVISIBLE", "MAGIC", "NODROP", "BLESS",
+	"ANTI-GOOD", "ANTI-EVIL", "ANTI-NEUTRAL", "ANTI-MAGIC-USER", "ANTI-CLERIC", "ANTI-THIEF",
+	"ANTI-WARRIOR", "NOSELL", "NOPICK",
+}
+
+// BitNames returns the names of the bits set in bits, or NOBITS if none are
+func BitNames(bits uint32, names []string) string {
+	var set []string
+	for i, name := range names {
+		if bits&(1<<uint(i)) != 0 {
+			set = append(set, name)
+		}
+	}
+	if len(set) == 0 {
+		return "NOBITS"
+	}
+	return strings.Join(set, " ")
+}
+
 // Item wear flag constants
 const (
 	ITEM_WEAR_TAKE   = (1 << 0)
@@ -99,6 +157,46 @@ const (
 	CONT_LOCKED    = 8
 )
 
+// ContainerFlags returns the current CONT_* flags of a container. Instances
+// are given the flags of their prototype when they are created and keep
+// their own from then on.
+func (o *ObjectInstance) ContainerFlags() int {
+	return o.Value[1]
+}
+
+// SetContainerFlags sets the current CONT_* flags of a container
+func (o *ObjectInstance) SetContainerFlags(flags int) {
+	o.Value[1] = flags
+}
+
+// LightHours returns the hours a light has left to burn, or -1 for a light
+// that never goes out. The prototype's Value[2] holds the hours a light
+// starts with and the instance's Value[2] the hours it has burned since.
+func (o *ObjectInstance) LightHours() int {
+	hours := o.Prototype.Value[2]
+	if hours < 0 {
+		return -1
+	}
+	if left := hours - o.Value[2]; left > 0 {
+		return left
+	}
+	return 0
+}
+
+// BurnLight burns a light for an hour and returns the hours it has left
+func (o *ObjectInstance) BurnLight() int {
+	if o.LightHours() > 0 {
+		o.Value[2]++
+	}
+	return o.LightHours()
+}
+
+// IsBurningLight returns true if the object is a light that hasn't burned
+// out
+func (o *ObjectInstance) IsBurningLight() bool {
+	return o.Prototype.Type == ITEM_LIGHT && o.LightHours() != 0
+}
+
 // CanClassUseItem checks if a character class can use a specific item
 func CanClassUseItem(class int, obj *Object) bool {
 	// Check class-specific anti-flags
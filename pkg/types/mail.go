@@ -0,0 +1,14 @@
+package types
+
+import "time"
+
+// STAMP_PRICE is what the postmaster charges to send a letter
+const STAMP_PRICE = 150
+
+// MailMessage represents a letter waiting at the post office
+type MailMessage struct {
+	From string
+	To   string
+	Text string
+	Sent time.Time
+}
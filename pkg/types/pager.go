@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PAGE_LENGTH is the number of lines shown per page of long output
+const PAGE_LENGTH = 22
+
+// pagerPrompt is shown after each page but the last
+const pagerPrompt = "[ Return to continue, (q)uit, (r)efresh, (b)ack, or page number (%d/%d) ]\r\n"
+
+// PageString shows long output a page at a time. Text that fits on one page
+// is sent straight away; otherwise the character is left paging and their
+// input goes to ShowPage until they reach the end or quit.
+func (c *Character) PageString(text string) {
+	lines := strings.Split(strings.TrimRight(strings.ReplaceAll(text, "\r\n", "\n"), "\n"), "\n")
+
+	var pages []string
+	for start := 0; start < len(lines); start += PAGE_LENGTH {
+		end := min(start+PAGE_LENGTH, len(lines))
+		pages = append(pages, strings.Join(lines[start:end], "\r\n")+"\r\n")
+	}
+
+	c.pages = pages
+	c.showPage(0)
+}
+
+// IsPaging returns true if the character is paging through long output
+func (c *Character) IsPaging() bool {
+	return len(c.pages) > 0
+}
+
+// ShowPage handles input while paging: return shows the next page, "q"
+// stops paging, "r" shows the page again, "b" goes back a page and a number
+// jumps to that page
+func (c *Character) ShowPage(input string) {
+	input = strings.ToLower(strings.TrimSpace(input))
+
+	switch {
+	case input == "":
+		c.showPage(c.page + 1)
+	case input[0] == 'q':
+		c.pages = nil
+	case input[0] == 'r':
+		c.showPage(c.page)
+	case input[0] == 'b':
+		c.showPage(max(0, c.page-1))
+	default:
+		if n, err := strconv.Atoi(input); err == nil {
+			c.showPage(max(0, min(n-1, len(c.pages)-1)))
+		} else {
+			c.SendMessage("Valid commands while paging are RETURN, Q, R, B, or a numeric value.\r\n")
+		}
+	}
+}
+
+// showPage sends the given page, and stops paging after the last one
+func (c *Character) showPage(page int) {
+	if page >= len(c.pages) {
+		c.pages = nil
+		return
+	}
+
+	c.page = page
+	c.SendMessage(c.pages[page])
+
+	if page == len(c.pages)-1 {
+		c.pages = nil
+		return
+	}
+	c.SendMessage(fmt.Sprintf(pagerPrompt, page+1, len(c.pages)))
+}
@@ -0,0 +1,8 @@
+package types
+
+// Pose represents one tier of pose messages loaded from the poses file
+type Pose struct {
+	Level   int       // Minimum level for this tier
+	CharMsg [4]string // Message to the poser, indexed by class - 1
+	RoomMsg [4]string // Message to the room, indexed by class - 1
+}
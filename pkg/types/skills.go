@@ -179,6 +179,12 @@ func GetSkillDelay(skill int) int {
 	return 1 // Default to 1 round
 }
 
+// SkillAffectType returns the affect type for affects a skill puts on a
+// character. Skills are numbered after the spells so the two never clash.
+func SkillAffectType(skill int) int {
+	return MAX_SPELLS + skill
+}
+
 // GetSkillClass returns the class that can use a skill
 func GetSkillClass(skill int) int {
 	switch skill {
@@ -0,0 +1,23 @@
+package types
+
+// Social represents a social action loaded from the actions file
+type Social struct {
+	Number            int    // Original DikuMUD command number
+	Name              string // Command name used to invoke the social
+	Hide              bool   // Whether the action is hidden from those who can't see the actor
+	MinVictimPosition int    // Minimum position the target must be in
+
+	CharNoArg   string // Message to the actor when no argument is given
+	OthersNoArg string // Message to the room when no argument is given
+	CharFound   string // Message to the actor when the target is found
+	OthersFound string // Message to the room when the target is found
+	VictFound   string // Message to the target
+	NotFound    string // Message to the actor when the target is not found
+	CharAuto    string // Message to the actor when targeting themselves
+	OthersAuto  string // Message to the room when the actor targets themselves
+}
+
+// TakesTarget returns true if the social can be directed at someone
+func (s *Social) TakesTarget() bool {
+	return s.CharFound != ""
+}
@@ -1,56 +0,0 @@
-package types
-
-// SpellWearOffMessages contains messages displayed when spell effects wear off
-var SpellWearOffMessages = []string{
-	"", // 0 - No spell
-	"You feel less protected.", // 1 - SPELL_ARMOR
-	"The white aura around your body fades.", // 2 - SPELL_BLESS
-	"You feel less blind.", // 3 - SPELL_BLINDNESS
-	"You feel more self-confident.", // 4 - SPELL_CHARM
-	"You feel more clumsy.", // 5 - SPELL_CHILL_TOUCH
-	"You feel more optimistic.", // 6 - SPELL_CURSE
-	"You feel less aware of your surroundings.", // 7 - SPELL_DETECT_EVIL
-	"You feel less aware of your surroundings.", // 8 - SPELL_DETECT_INVISIBLE
-	"You feel less aware of your surroundings.", // 9 - SPELL_DETECT_MAGIC
-	"Your night vision seems to fade.", // 10 - SPELL_INFRAVISION
-	"You feel visible again.", // 11 - SPELL_INVISIBLE
-	"You feel less protected from evil.", // 12 - SPELL_PROTECTION_FROM_EVIL
-	"You feel less tired.", // 13 - SPELL_SLEEP
-	"The white aura around your body fades.", // 14 - SPELL_SANCTUARY
-	"Your skin feels soft again.", // 15 - SPELL_STONE_SKIN
-	"Your strength returns to normal.", // 16 - SPELL_STRENGTH
-	"You feel less aware of your surroundings.", // 17 - SPELL_SENSE_LIFE
-	"You feel less heroic.", // 18 - SPELL_HEROISM
-	"You feel less agile.", // 19 - SPELL_AGILITY
-	"You feel less wise.", // 20 - SPELL_WISDOM
-	"You feel less intelligent.", // 21 - SPELL_INTELLIGENCE
-	"You feel less healthy.", // 22 - SPELL_HEALTH
-	"You feel less charismatic.", // 23 - SPELL_CHARISMA
-	"You feel less protected from fire.", // 24 - SPELL_FIRE_PROTECTION
-	"You feel less protected from cold.", // 25 - SPELL_COLD_PROTECTION
-	"You feel less protected from lightning.", // 26 - SPELL_LIGHTNING_PROTECTION
-	"You feel less protected from acid.", // 27 - SPELL_ACID_PROTECTION
-	"You feel less protected from poison.", // 28 - SPELL_POISON_PROTECTION
-	"You feel less protected from disease.", // 29 - SPELL_DISEASE_PROTECTION
-	"You feel less protected from drowning.", // 30 - SPELL_DROWNING_PROTECTION
-	"You feel less protected from falling.", // 31 - SPELL_FALLING_PROTECTION
-	"You feel less protected from energy.", // 32 - SPELL_ENERGY_PROTECTION
-	"You feel less protected from magic.", // 33 - SPELL_MAGIC_PROTECTION
-	"You feel less protected from weapons.", // 34 - SPELL_WEAPON_PROTECTION
-	"You feel less protected from backstab.", // 35 - SPELL_BACKSTAB_PROTECTION
-	"You feel less protected from theft.", // 36 - SPELL_THEFT_PROTECTION
-	"You feel less protected from traps.", // 37 - SPELL_TRAP_PROTECTION
-	"You feel less protected from pick.", // 38 - SPELL_PICK_PROTECTION
-	"You feel less protected from sneak.", // 39 - SPELL_SNEAK_PROTECTION
-	"You feel less protected from hide.", // 40 - SPELL_HIDE_PROTECTION
-	"You feel less protected from track.", // 41 - SPELL_TRACK_PROTECTION
-	"You feel less protected from search.", // 42 - SPELL_SEARCH_PROTECTION
-	"You feel less protected from identify.", // 43 - SPELL_IDENTIFY_PROTECTION
-	"You feel less protected from detect.", // 44 - SPELL_DETECT_PROTECTION
-	"You feel less protected from locate.", // 45 - SPELL_LOCATE_PROTECTION
-	"You feel less protected from summon.", // 46 - SPELL_SUMMON_PROTECTION
-	"You feel less protected from charm.", // 47 - SPELL_CHARM_PROTECTION
-	"You feel less protected from hold.", // 48 - SPELL_HOLD_PROTECTION
-	"You feel less protected from breath.", // 49 - SPELL_BREATH_PROTECTION
-	"You feel less protected from spells.", // 50 - SPELL_SPELL_PROTECTION
-}
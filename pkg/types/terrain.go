@@ -0,0 +1,50 @@
+package types
+
+import "fmt"
+
+// MovementCost returns the move points it takes to walk from one room to
+// the other
+func MovementCost(from, to *Room) int {
+	return (sectorLoss(from.SectorType) + sectorLoss(to.SectorType)) / 2
+}
+
+// sectorLoss returns the move points it takes to cross a sector type
+func sectorLoss(sector int) int {
+	if sector < 0 || sector >= len(MovementLoss) {
+		return MovementLoss[SECT_INSIDE]
+	}
+	return MovementLoss[sector]
+}
+
+// CheckTerrain returns an error if the character can't get into the room
+// from where it stands: water too deep to swim needs a boat, underwater
+// needs water breathing and the open air needs flight
+func CheckTerrain(ch *Character, destRoom *Room) error {
+	switch destRoom.SectorType {
+	case SECT_UNDERWATER:
+		if ch.AffectedBy&AFF_WATERBREATH == 0 {
+			return fmt.Errorf("You would need to breathe water to go there.")
+		}
+	case SECT_FLYING:
+		if ch.AffectedBy&AFF_FLYING == 0 {
+			return fmt.Errorf("You would need to fly to go there.")
+		}
+	}
+
+	if (ch.InRoom.SectorType == SECT_WATER_NOSWIM || destRoom.SectorType == SECT_WATER_NOSWIM) &&
+		ch.AffectedBy&AFF_FLYING == 0 && !hasBoat(ch) {
+		return fmt.Errorf("You need a boat to go there.")
+	}
+
+	return nil
+}
+
+// hasBoat returns true if the character carries a boat
+func hasBoat(ch *Character) bool {
+	for _, obj := range ch.Inventory {
+		if obj.Prototype != nil && obj.Prototype.Type == ITEM_BOAT {
+			return true
+		}
+	}
+	return false
+}
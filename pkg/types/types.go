@@ -23,6 +23,7 @@ type Room struct {
 	Objects     []*ObjectInstance
 	ExtraDescs  []*ExtraDescription
 	Functions   []func(*Character, string) bool // Special procedures
+	Light       int                             // Number of lights burning here
 	Zone        *Zone
 	Shop        *Shop
 	mutex       sync.RWMutex // Internal mutex
@@ -71,6 +72,7 @@ type Object struct {
 	Value       [4]int
 	Weight      int
 	Cost        int
+	RentCost    int // Rent per day charged by receptionists
 	ExtraDescs  []*ExtraDescription
 	Affects     [MAX_OBJ_AFFECT]struct {
 		Location int
@@ -125,6 +127,7 @@ type Mobile struct {
 	Dice        [3]int                          // num, size, bonus
 	Abilities   [6]int                          // STR, INT, WIS, DEX, CON, CHA
 	Functions   []func(*Character, string) bool // Special procedures
+	Receivers   []func(*Character, string) bool // Special procedures run when given something
 	Equipment   []MobEquipment                  // Default equipment
 }
 
@@ -155,6 +158,8 @@ type Character struct {
 	Position      int
 	Gold          int
 	Experience    int
+	Practices     int // Practice sessions left
+	Wimpy         int // Flee when hit points drop below this
 	Alignment     int
 	HP            int // Current hit points
 	MaxHitPoints  int
@@ -185,14 +190,21 @@ type Character struct {
 	ActFlags      uint32                          // NPC behavior flags
 	Prototype     *Mobile                         // If NPC
 	Functions     []func(*Character, string) bool // Special procedures
+	Receivers     []func(*Character, string) bool // Special procedures run when given something
 	LastLogin     time.Time
-	Password      string // Hashed
+	RentTime      time.Time // When the character rented, zero if not renting
+	RentCost      int       // Rent per day owed since RentTime
+	Password      string    // Hashed
 	Title         string
 	Prompt        string
 	Flags         uint32
+	LastTellFrom  string      // Name of the last character who sent a tell (for reply)
 	Messages      []string    // Special messages for the character
 	World         interface{} // Reference to the world
 	Client        interface{} // Reference to the client
+	pages         []string    // Long output being paged
+	page          int         // Page of pages being shown
+	editor        *editor     // Text being written, e.g. a board message
 	mutex         sync.RWMutex
 }
 
@@ -9,10 +9,13 @@ import (
 // Act sends a message to characters in a room
 // ch is the character performing the action
 // msg is the message to send
-// hide is whether to hide the action from others
+// hide is whether to hide the action from those who can't see ch
 // obj is an optional object involved in the action
 // vict is an optional victim of the action
 // type is the type of message (TO_CHAR, TO_ROOM, TO_VICT, TO_NOTVICT)
+//
+// Each recipient gets the message as they see it: characters they can't
+// see become "someone" and objects they can't see become "something".
 func (w *World) Act(msg string, hide bool, ch *types.Character, obj *types.ObjectInstance, vict *types.Character, msgType int) {
 	// Check for invalid parameters
 	if msg == "" || ch == nil {
@@ -25,58 +28,81 @@ func (w *World) Act(msg string, hide bool, ch *types.Character, obj *types.Objec
 		return
 	}
 
-	// Process the message
-	processedMsg := processActMessage(msg, ch, obj, vict)
+	// send delivers the message to one recipient. Sleeping characters
+	// don't notice anything going on around them.
+	send := func(to *types.Character) {
+		if !awake(to) || (hide && !w.CanSee(to, ch)) {
+			return
+		}
+		to.SendMessage(w.processActMessage(msg, ch, obj, vict, to))
+	}
 
 	// Send the message to the appropriate recipients
 	switch msgType {
 	case types.TO_CHAR:
 		// Send to the character
-		if ch != nil {
-			ch.SendMessage(processedMsg)
-		}
+		send(ch)
 	case types.TO_ROOM:
 		// Send to everyone in the room except the character
 		for _, rch := range room.Characters {
-			if rch != ch && (rch.Position > types.POS_SLEEPING || !hide) {
-				rch.SendMessage(processedMsg)
+			if rch != ch {
+				send(rch)
 			}
 		}
 	case types.TO_VICT:
 		// Send to the victim
 		if vict != nil {
-			vict.SendMessage(processedMsg)
+			send(vict)
 		}
 	case types.TO_NOTVICT:
 		// Send to everyone in the room except the character and victim
 		for _, rch := range room.Characters {
-			if rch != ch && rch != vict && (rch.Position > types.POS_SLEEPING || !hide) {
-				rch.SendMessage(processedMsg)
+			if rch != ch && rch != vict {
+				send(rch)
 			}
 		}
 	case types.TO_ALL:
 		// Send to everyone in the room
 		for _, rch := range room.Characters {
-			if rch.Position > types.POS_SLEEPING || !hide {
-				rch.SendMessage(processedMsg)
-			}
+			send(rch)
 		}
 	}
 }
 
-// processActMessage replaces placeholders in the message with actual names
-func processActMessage(msg string, ch *types.Character, obj *types.ObjectInstance, vict *types.Character) string {
+// awake returns true if the character is conscious enough to notice things
+func awake(ch *types.Character) bool {
+	return ch.Position > types.POS_SLEEPING
+}
+
+// pers returns the name ch goes by for to, or "someone" if to can't see ch
+func (w *World) pers(ch, to *types.Character) string {
+	if !w.CanSee(to, ch) {
+		return "someone"
+	}
+	if ch.IsNPC && ch.ShortDesc != "" {
+		return ch.ShortDesc
+	}
+	return ch.Name
+}
+
+// processActMessage replaces placeholders in the message with the names
+// the recipient knows things by
+func (w *World) processActMessage(msg string, ch *types.Character, obj *types.ObjectInstance, vict *types.Character, to *types.Character) string {
 	// Replace $n with the character's name
-	msg = strings.ReplaceAll(msg, "$n", ch.Name)
+	msg = strings.ReplaceAll(msg, "$n", w.pers(ch, to))
 
 	// Replace $N with the victim's name
 	if vict != nil {
-		msg = strings.ReplaceAll(msg, "$N", vict.Name)
+		msg = strings.ReplaceAll(msg, "$N", w.pers(vict, to))
 	}
 
 	// Replace $p with the object's name
 	if obj != nil {
-		msg = strings.ReplaceAll(msg, "$p", obj.Prototype.ShortDesc)
+		if w.CanSeeObj(to, obj) {
+			msg = strings.ReplaceAll(msg, "$p", obj.Prototype.ShortDesc)
+		} else {
+			msg = strings.ReplaceAll(msg, "$p", "something")
+		}
 	}
 
 	// Replace $m with him/her
@@ -118,6 +144,21 @@ func processActMessage(msg string, ch *types.Character, obj *types.ObjectInstanc
 		msg = strings.ReplaceAll(msg, "$e", heShe)
 	}
 
+	// Replace $M, $S and $E with the victim's him/her, his/her and he/she
+	if vict != nil {
+		var himHer, hisHer, heShe string
+		if vict.Sex == types.SEX_MALE {
+			himHer, hisHer, heShe = "him", "his", "he"
+		} else if vict.Sex == types.SEX_FEMALE {
+			himHer, hisHer, heShe = "her", "her", "she"
+		} else {
+			himHer, hisHer, heShe = "it", "its", "it"
+		}
+		msg = strings.ReplaceAll(msg, "$M", himHer)
+		msg = strings.ReplaceAll(msg, "$S", hisHer)
+		msg = strings.ReplaceAll(msg, "$E", heShe)
+	}
+
 	// Replace $T with the third argument (string)
 	if vict != nil && vict.Name == "" {
 		msg = strings.ReplaceAll(msg, "$T", vict.Description)
@@ -0,0 +1,70 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+func TestActSkipsSleepingCharacters(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	received := make(map[*types.Character][]string)
+	world.SetMessageHandler(func(ch *types.Character, message string) {
+		received[ch] = append(received[ch], message)
+	})
+
+	room := &types.Room{VNUM: 3001}
+	actor := &types.Character{Name: "Alice", Position: types.POS_STANDING, InRoom: room, World: world}
+	awakeChar := &types.Character{Name: "Bob", Position: types.POS_RESTING, InRoom: room, World: world}
+	sleeper := &types.Character{Name: "Carol", Position: types.POS_SLEEPING, InRoom: room, World: world}
+	room.Characters = []*types.Character{actor, awakeChar, sleeper}
+
+	world.Act("$n dances.", false, actor, nil, nil, types.TO_ROOM)
+	world.Act("$n pokes you.", false, actor, nil, sleeper, types.TO_VICT)
+
+	if len(received[awakeChar]) != 1 || received[awakeChar][0] != "Alice dances.\r\n" {
+		t.Errorf("Expected Bob to see the dance, got %q", received[awakeChar])
+	}
+	if len(received[sleeper]) != 0 {
+		t.Errorf("Expected Carol to see nothing while asleep, got %q", received[sleeper])
+	}
+}
+
+func TestActNamesUnseenCharactersSomeone(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	received := make(map[*types.Character][]string)
+	world.SetMessageHandler(func(ch *types.Character, message string) {
+		received[ch] = append(received[ch], message)
+	})
+
+	room := &types.Room{VNUM: 3001}
+	ghost := &types.Character{Name: "Ghost", Position: types.POS_STANDING, InRoom: room, World: world,
+		AffectedBy: types.AFF_INVISIBLE}
+	seer := &types.Character{Name: "Seer", Position: types.POS_STANDING, InRoom: room, World: world,
+		AffectedBy: types.AFF_DETECT_INVISIBLE}
+	mortal := &types.Character{Name: "Mortal", Position: types.POS_STANDING, InRoom: room, World: world}
+	fido := &types.Character{Name: "fido dog", ShortDesc: "a fido", IsNPC: true, Position: types.POS_STANDING, InRoom: room, World: world}
+	room.Characters = []*types.Character{ghost, seer, mortal, fido}
+
+	world.Act("$n pats $N.", false, ghost, nil, fido, types.TO_NOTVICT)
+	if len(received[seer]) != 1 || received[seer][0] != "Ghost pats a fido.\r\n" {
+		t.Errorf("Expected the seer to see the ghost, got %q", received[seer])
+	}
+	if len(received[mortal]) != 1 || received[mortal][0] != "someone pats a fido.\r\n" {
+		t.Errorf("Expected the mortal to see someone, got %q", received[mortal])
+	}
+
+	received = make(map[*types.Character][]string)
+	world.Act("$n sneezes.", true, ghost, nil, nil, types.TO_ROOM)
+	if len(received[seer]) != 1 || len(received[mortal]) != 0 {
+		t.Errorf("Expected a hidden act to reach only those who see the ghost, got %q and %q", received[seer], received[mortal])
+	}
+}
@@ -39,12 +39,12 @@ func (w *World) PulseAffectUpdate() {
 
 			// Check if the affect has expired
 			if affect.Duration <= 0 {
-				// Send wear-off message if applicable
-				if affect.Type > 0 && affect.Type < len(types.SpellWearOffMessages) {
-					message := types.SpellWearOffMessages[affect.Type]
-					if message != "" {
-						character.SendMessage(message + "\r\n")
-					}
+				// Send wear-off message if applicable. A spell that left
+				// several affects only says so once, with the last of them.
+				sameSpellExpiring := nextAffect != nil && nextAffect.Type == affect.Type &&
+					nextAffect.Duration >= 0 && nextAffect.Duration <= 1
+				if info, ok := types.SpellData[affect.Type]; ok && info.WearOff != "" && !sameSpellExpiring {
+					character.SendMessage(info.WearOff + "\r\n")
 				}
 
 				// Remove the affect
@@ -0,0 +1,102 @@
+package world
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/wltechblog/DikuGo/pkg/storage"
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// boardVnums are the objects that act as bulletin boards, as assigned in
+// the original spec_assign.c
+var boardVnums = map[int]bool{
+	3099: true, // The board in the Temple
+}
+
+// boards holds the messages posted on each bulletin board by object vnum
+type boards struct {
+	messages map[int][]*types.BoardMessage
+	mutex    sync.RWMutex
+}
+
+// boardFile returns the file the board messages are kept in
+func (w *World) boardFile() string {
+	return filepath.Join(w.DataPath(), "board.messages")
+}
+
+// LoadBoards loads the board messages from disk, replacing any loaded
+// before. It returns the number of messages loaded.
+func (w *World) LoadBoards() (int, error) {
+	messages, err := storage.LoadBoardMessages(w.boardFile())
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, posts := range messages {
+		count += len(posts)
+	}
+
+	w.boards.mutex.Lock()
+	w.boards.messages = messages
+	w.boards.mutex.Unlock()
+
+	return count, nil
+}
+
+// IsBoard returns true if the object is a bulletin board
+func (w *World) IsBoard(obj *types.ObjectInstance) bool {
+	return obj != nil && obj.Prototype != nil && boardVnums[obj.Prototype.VNUM]
+}
+
+// BoardMessages returns the messages posted on a board, oldest first
+func (w *World) BoardMessages(board *types.ObjectInstance) []*types.BoardMessage {
+	w.boards.mutex.RLock()
+	defer w.boards.mutex.RUnlock()
+
+	posts := w.boards.messages[board.Prototype.VNUM]
+	return append([]*types.BoardMessage(nil), posts...)
+}
+
+// PostBoardMessage adds a message to a board and saves the boards
+func (w *World) PostBoardMessage(board *types.ObjectInstance, author, title, text string) error {
+	w.boards.mutex.Lock()
+	defer w.boards.mutex.Unlock()
+
+	vnum := board.Prototype.VNUM
+	if len(w.boards.messages[vnum]) >= types.MAX_BOARD_MESSAGES {
+		return fmt.Errorf("The board is full already.")
+	}
+
+	if w.boards.messages == nil {
+		w.boards.messages = make(map[int][]*types.BoardMessage)
+	}
+	w.boards.messages[vnum] = append(w.boards.messages[vnum], &types.BoardMessage{
+		Author: author,
+		Title:  title,
+		Text:   text,
+		Posted: time.Now(),
+	})
+
+	return storage.SaveBoardMessages(w.boardFile(), w.boards.messages)
+}
+
+// RemoveBoardMessage removes message number n, counting from 1, from a
+// board and saves the boards
+func (w *World) RemoveBoardMessage(board *types.ObjectInstance, n int) error {
+	w.boards.mutex.Lock()
+	defer w.boards.mutex.Unlock()
+
+	vnum := board.Prototype.VNUM
+	posts := w.boards.messages[vnum]
+	if n < 1 || n > len(posts) {
+		return fmt.Errorf("That message exists only in your imagination..")
+	}
+
+	w.boards.messages[vnum] = append(posts[:n-1:n-1], posts[n:]...)
+
+	return storage.SaveBoardMessages(w.boardFile(), w.boards.messages)
+}
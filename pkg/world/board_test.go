@@ -0,0 +1,54 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/config"
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+func TestBoardMessagesSurviveReload(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Game.DataPath = t.TempDir()
+
+	world, err := NewWorld(cfg, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	board := &types.ObjectInstance{Prototype: &types.Object{VNUM: 3099, Name: "board bulletin"}}
+	if !world.IsBoard(board) {
+		t.Fatal("Expected object 3099 to be a board")
+	}
+
+	for _, title := range []string{"First", "Second", "Third"} {
+		if err := world.PostBoardMessage(board, "Alice", title, "Hello.\r\n"); err != nil {
+			t.Fatalf("Failed to post: %v", err)
+		}
+	}
+	if err := world.RemoveBoardMessage(board, 2); err != nil {
+		t.Fatalf("Failed to remove: %v", err)
+	}
+	if err := world.RemoveBoardMessage(board, 3); err == nil {
+		t.Error("Expected removing a missing message to fail")
+	}
+
+	// A reboot reads the messages back from disk
+	if count, err := world.LoadBoards(); err != nil || count != 2 {
+		t.Fatalf("Expected 2 messages after reload, got %d (%v)", count, err)
+	}
+	posts := world.BoardMessages(board)
+	if posts[0].Title != "First" || posts[1].Title != "Third" || posts[1].Author != "Alice" {
+		t.Errorf("Unexpected messages after reload: %+v %+v", posts[0], posts[1])
+	}
+
+	// Boards hold a limited number of messages
+	for i := len(posts); i < types.MAX_BOARD_MESSAGES; i++ {
+		if err := world.PostBoardMessage(board, "Alice", "More", ""); err != nil {
+			t.Fatalf("Failed to post: %v", err)
+		}
+	}
+	if err := world.PostBoardMessage(board, "Alice", "Too many", ""); err == nil {
+		t.Error("Expected a full board to refuse messages")
+	}
+}
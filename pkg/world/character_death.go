@@ -14,6 +14,9 @@ func (w *World) HandleCharacterDeath(victim *types.Character) {
 
 	log.Printf("HandleCharacterDeath: Character %s has died", victim.Name)
 
+	// The dead stop following and lose their followers
+	w.DieFollower(victim)
+
 	// Create a corpse with the victim's items
 	w.MakeCorpse(victim)
 
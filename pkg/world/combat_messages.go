@@ -0,0 +1,90 @@
+package world
+
+import (
+	"path/filepath"
+	"sync"
+
+	"github.com/wltechblog/DikuGo/pkg/storage"
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// combatMessages holds the messages loaded from the messages file, keyed
+// by attack type
+type combatMessages struct {
+	messages map[int][]*types.CombatMessage
+	mutex    sync.RWMutex
+}
+
+// LoadMessages loads the combat messages from the lib directory, replacing
+// any loaded before. It returns the number of message sets loaded.
+func (w *World) LoadMessages() (int, error) {
+	messages, err := storage.ParseMessages(filepath.Join(w.DataPath(), "messages"))
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, sets := range messages {
+		count += len(sets)
+	}
+
+	w.combatMessages.mutex.Lock()
+	w.combatMessages.messages = messages
+	w.combatMessages.mutex.Unlock()
+
+	return count, nil
+}
+
+// HasAttackMessages returns true if the messages file has messages for the
+// attack type
+func (w *World) HasAttackMessages(attackType int) bool {
+	w.combatMessages.mutex.RLock()
+	defer w.combatMessages.mutex.RUnlock()
+	return len(w.combatMessages.messages[attackType]) > 0
+}
+
+// attackMessage returns one of the message sets for the attack type at
+// random, or nil if there are none
+func (w *World) attackMessage(attackType int) *types.CombatMessage {
+	w.combatMessages.mutex.RLock()
+	defer w.combatMessages.mutex.RUnlock()
+
+	sets := w.combatMessages.messages[attackType]
+	if len(sets) == 0 {
+		return nil
+	}
+	return sets[w.rand.Intn(len(sets))]
+}
+
+// DamageMessages shows the attacker, the victim and the room the messages
+// for an attack that has already done its damage. Attacks on immortals get
+// the immortal messages, attacks that do no damage the miss messages and
+// attacks that leave the victim dead the kill messages. It returns false if
+// the messages file has none for the attack type.
+func (w *World) DamageMessages(ch, victim *types.Character, damage, attackType int) bool {
+	msg := w.attackMessage(attackType)
+	if msg == nil {
+		return false
+	}
+
+	triple := msg.Hit
+	switch {
+	case !victim.IsNPC && victim.Level >= types.LEVEL_IMMORTAL:
+		triple = msg.God
+	case damage == 0:
+		triple = msg.Miss
+	case victim.HP <= 0:
+		triple = msg.Die
+	}
+
+	// The messages may name the attacker's weapon as $p
+	var weapon *types.ObjectInstance
+	if len(ch.Equipment) > types.WEAR_WIELD {
+		weapon = ch.Equipment[types.WEAR_WIELD]
+	}
+
+	w.Act(triple.Attacker, false, ch, weapon, victim, types.TO_CHAR)
+	w.Act(triple.Victim, false, ch, weapon, victim, types.TO_VICT)
+	w.Act(triple.Room, false, ch, weapon, victim, types.TO_NOTVICT)
+	return true
+}
@@ -0,0 +1,96 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/config"
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+func TestDamageShowsMessagesFromFile(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Game.DataPath = "../../lib"
+
+	world, err := NewWorld(cfg, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+	if !world.HasAttackMessages(types.SpellAttackType(types.SPELL_BURNING_HANDS)) {
+		t.Fatal("Expected burning hands messages to be loaded")
+	}
+
+	received := make(map[*types.Character][]string)
+	world.SetMessageHandler(func(ch *types.Character, message string) {
+		received[ch] = append(received[ch], message)
+	})
+
+	room := &types.Room{VNUM: 3001}
+	mage := &types.Character{Name: "Alice", Position: types.POS_STANDING, InRoom: room, World: world}
+	fido := &types.Character{Name: "fido", ShortDesc: "a fido", IsNPC: true, HP: 100, Position: types.POS_STANDING,
+		InRoom: room, World: world}
+	god := &types.Character{Name: "Zeus", Level: types.LEVEL_IMMORTAL, HP: 100, Position: types.POS_STANDING,
+		InRoom: room, World: world}
+	room.Characters = []*types.Character{mage, fido, god}
+
+	tests := []struct {
+		name     string
+		victim   *types.Character
+		damage   int
+		expectHP int
+		expect   string
+	}{
+		{"miss", fido, 0, 100, "You miss a fido with your burning hands"},
+		{"hit", fido, 10, 90, "You burned a fido"},
+		{"kill", fido, 200, 0, "You burned a fido to death"},
+		{"god", god, 50, 100, "Your attempt to burn Zeus nears BLASPHEMY !!!"},
+	}
+
+	for _, tt := range tests {
+		received = make(map[*types.Character][]string)
+		world.Damage(mage, tt.victim, tt.damage, types.SpellAttackType(types.SPELL_BURNING_HANDS))
+
+		if tt.victim.HP != tt.expectHP {
+			t.Errorf("%s: expected HP %d, got %d", tt.name, tt.expectHP, tt.victim.HP)
+		}
+		if len(received[mage]) == 0 || received[mage][0] != tt.expect+"\r\n" {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.expect, received[mage])
+		}
+	}
+
+	// Attack types the file doesn't cover show nothing
+	received = make(map[*types.Character][]string)
+	if world.DamageMessages(mage, god, 1, types.WeaponAttackType(types.TYPE_CRUSH)) || len(received[mage]) != 0 {
+		t.Errorf("Expected no crush messages, got %q", received[mage])
+	}
+}
+
+func TestWeaponsUseMessagesFromFile(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Game.DataPath = "../../lib"
+
+	world, err := NewWorld(cfg, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	// Weapon types as the dagger (#3020) and small mace in lib/tinyworld.obj
+	// store them
+	tests := []struct {
+		name   string
+		value  int
+		expect int
+	}{
+		{"dagger", 11, types.TYPE_PIERCE},
+		{"mace", 7, types.TYPE_BLUDGEON},
+	}
+
+	for _, tt := range tests {
+		weaponType := types.WeaponType(tt.value)
+		if weaponType != tt.expect {
+			t.Errorf("%s: expected weapon type %d, got %d", tt.name, tt.expect, weaponType)
+		}
+		if !world.HasAttackMessages(types.WeaponAttackType(weaponType)) {
+			t.Errorf("%s: expected messages for attack type %d", tt.name, types.WeaponAttackType(weaponType))
+		}
+	}
+}
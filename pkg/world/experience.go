@@ -0,0 +1,108 @@
+package world
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+	"github.com/wltechblog/DikuGo/pkg/utils"
+)
+
+// GainExp adds (or with a negative gain, removes) experience and advances
+// the character's level when it passes the next threshold in the class's
+// experience table. This follows gain_exp from the original DikuMUD.
+func (w *World) GainExp(ch *types.Character, gain int) {
+	// Immortals don't gain or lose experience
+	if !ch.IsNPC && (ch.Level <= 0 || ch.Level >= types.LEVEL_IMMORTAL) {
+		return
+	}
+
+	if gain < 0 {
+		ch.Experience += max(-types.MAX_EXP_LOSS, gain)
+		if ch.Experience < 0 {
+			ch.Experience = 0
+		}
+		return
+	}
+
+	ch.Experience += min(types.MAX_EXP_GAIN, gain)
+
+	// NPCs and characters without a class table never advance
+	if ch.IsNPC {
+		return
+	}
+	if _, ok := types.ClassTitles[ch.Class]; !ok {
+		return
+	}
+
+	// Mortals can't earn their way past the immortal boundary
+	maxExp := types.GetExpForLevel(ch.Class, types.LEVEL_IMMORTAL) - 1
+	if ch.Experience > maxExp {
+		ch.Experience = maxExp
+	}
+
+	leveled := false
+	for ch.Level < types.LEVEL_IMMORTAL-1 && ch.Experience >= types.GetExpForLevel(ch.Class, ch.Level+1) {
+		ch.Level++
+		ch.SendMessage("You raise a level!\r\n")
+		w.advanceLevel(ch)
+		leveled = true
+	}
+
+	if leveled {
+		w.setTitle(ch)
+	}
+}
+
+// advanceLevel applies the hit point, mana and movement gains for a new level
+func (w *World) advanceLevel(ch *types.Character) {
+	addHP := utils.Dice(1, types.GetClassHitDie(ch.Class)) + getConstitutionHPBonus(ch.Abilities[types.ABILITY_CON])
+	addHP = max(1, addHP)
+	addMana := utils.Dice(1, types.GetClassManaDie(ch.Class))
+	addMove := utils.Dice(1, types.GetClassMoveDie(ch.Class))
+
+	ch.MaxHitPoints += addHP
+	ch.MaxManaPoints += addMana
+	ch.MaxMovePoints += addMove
+	w.gainPractices(ch)
+
+	ch.SendMessage(fmt.Sprintf("Your gain is: %d/%d hp, %d/%d mana, %d/%d mv.\r\n",
+		addHP, ch.MaxHitPoints, addMana, ch.MaxManaPoints, addMove, ch.MaxMovePoints))
+
+	log.Printf("%s advanced to level %d", ch.Name, ch.Level)
+}
+
+// gainPractices grants the practice sessions earned for a level. Spell
+// casters always get at least two, everyone else one or two depending on
+// wisdom.
+func (w *World) gainPractices(ch *types.Character) {
+	bonus := getWisdomPracticeBonus(ch.Abilities[types.ABILITY_WIS])
+	if ch.Class == types.CLASS_MAGIC_USER || ch.Class == types.CLASS_CLERIC {
+		ch.Practices += max(2, bonus)
+	} else {
+		ch.Practices += min(2, max(1, bonus))
+	}
+}
+
+// getWisdomPracticeBonus returns the practice session bonus for a given wisdom score
+func getWisdomPracticeBonus(wis int) int {
+	switch {
+	case wis <= 14:
+		return 0
+	case wis <= 16:
+		return 1
+	case wis <= 18:
+		return 2
+	case wis <= 21:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// setTitle sets the character's title from the class table
+func (w *World) setTitle(ch *types.Character) {
+	if title := types.GetClassTitle(ch.Class, ch.Level, ch.Sex); title != "" {
+		ch.Title = " " + title
+	}
+}
@@ -0,0 +1,86 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+func TestGainExpAdvancesLevel(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	ch := &types.Character{
+		Name:          "Warrior",
+		Class:         types.CLASS_WARRIOR,
+		Level:         1,
+		Sex:           types.SEX_FEMALE,
+		MaxHitPoints:  20,
+		MaxManaPoints: 50,
+		MaxMovePoints: 100,
+		Abilities:     [6]int{16, 10, 10, 10, 18, 10},
+	}
+
+	// Not enough for level 2
+	world.GainExp(ch, 1999)
+	if ch.Level != 1 {
+		t.Errorf("Expected level 1, got %d", ch.Level)
+	}
+
+	// Crossing two thresholds at once advances two levels
+	world.GainExp(ch, 2001)
+	if ch.Level != 3 {
+		t.Fatalf("Expected level 3, got %d", ch.Level)
+	}
+	if ch.Title != " the Sentress" {
+		t.Errorf("Expected female warrior title, got %q", ch.Title)
+	}
+
+	// Each level gains at least one hit point plus the CON bonus of 3
+	if ch.MaxHitPoints < 20+2*(1+3) {
+		t.Errorf("Expected hit points to increase with CON bonus, got %d", ch.MaxHitPoints)
+	}
+	if ch.MaxManaPoints <= 50 || ch.MaxMovePoints <= 100 {
+		t.Errorf("Expected mana and move to increase, got %d/%d", ch.MaxManaPoints, ch.MaxMovePoints)
+	}
+
+	// Losing experience never lowers the level or goes below zero
+	world.GainExp(ch, -10000)
+	if ch.Experience != 0 || ch.Level != 3 {
+		t.Errorf("Expected 0 exp at level 3, got %d exp at level %d", ch.Experience, ch.Level)
+	}
+}
+
+func TestGainExpImmortalCap(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	ch := &types.Character{
+		Name:       "Mage",
+		Class:      types.CLASS_MAGIC_USER,
+		Level:      20,
+		Experience: types.GetExpForLevel(types.CLASS_MAGIC_USER, 20),
+	}
+
+	for i := 0; i < 20; i++ {
+		world.GainExp(ch, 100000)
+	}
+
+	if ch.Level != types.LEVEL_IMMORTAL-1 {
+		t.Errorf("Expected mortal to stay at level %d, got %d", types.LEVEL_IMMORTAL-1, ch.Level)
+	}
+	if ch.Experience >= types.GetExpForLevel(types.CLASS_MAGIC_USER, types.LEVEL_IMMORTAL) {
+		t.Errorf("Expected experience to be capped below the immortal threshold, got %d", ch.Experience)
+	}
+
+	// Immortals don't gain experience at all
+	immortal := &types.Character{Name: "God", Class: types.CLASS_CLERIC, Level: types.LEVEL_IMMORTAL}
+	world.GainExp(immortal, 1000)
+	if immortal.Experience != 0 {
+		t.Errorf("Expected immortal experience to be unchanged, got %d", immortal.Experience)
+	}
+}
@@ -0,0 +1,77 @@
+package world
+
+import (
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// CircleFollow returns true if ch following victim would create a loop
+func (w *World) CircleFollow(ch, victim *types.Character) bool {
+	for k := victim; k != nil; k = k.Following {
+		if k == ch {
+			return true
+		}
+	}
+	return false
+}
+
+// AddFollower makes ch follow leader
+func (w *World) AddFollower(ch, leader *types.Character) {
+	if ch.Following != nil {
+		w.StopFollower(ch)
+	}
+
+	ch.Following = leader
+	leader.Followers = append(leader.Followers, ch)
+
+	w.Act("You now follow $N.", false, ch, nil, leader, types.TO_CHAR)
+	w.Act("$n starts following you.", true, ch, nil, leader, types.TO_VICT)
+	w.Act("$n now follows $N.", true, ch, nil, leader, types.TO_NOTVICT)
+}
+
+// StopFollower stops ch from following its leader and takes it out of the
+// leader's group. A charmed follower is released from the charm.
+func (w *World) StopFollower(ch *types.Character) {
+	leader := ch.Following
+	if leader == nil {
+		return
+	}
+
+	if ch.AffectedBy&types.AFF_CHARM != 0 {
+		w.Act("You realize that $N is a jerk!", false, ch, nil, leader, types.TO_CHAR)
+		w.Act("$n realizes that $N is a jerk!", false, ch, nil, leader, types.TO_NOTVICT)
+		w.Act("$n hates your guts!", false, ch, nil, leader, types.TO_VICT)
+		w.AffectFromChar(ch, types.SPELL_CHARM_PERSON)
+	} else {
+		w.Act("You stop following $N.", false, ch, nil, leader, types.TO_CHAR)
+		w.Act("$n stops following $N.", true, ch, nil, leader, types.TO_NOTVICT)
+		w.Act("$n stops following you.", true, ch, nil, leader, types.TO_VICT)
+	}
+
+	for i, follower := range leader.Followers {
+		if follower == ch {
+			leader.Followers = append(leader.Followers[:i], leader.Followers[i+1:]...)
+			break
+		}
+	}
+
+	ch.Following = nil
+	ch.AffectedBy &= ^types.AFF_GROUP
+}
+
+// DieFollower stops ch from following anyone and releases all of its
+// followers. It is called when a character dies or leaves the game.
+func (w *World) DieFollower(ch *types.Character) {
+	if ch.Following != nil {
+		w.StopFollower(ch)
+	}
+
+	// StopFollower modifies ch.Followers, so work on a copy
+	followers := make([]*types.Character, len(ch.Followers))
+	copy(followers, ch.Followers)
+	for _, follower := range followers {
+		w.StopFollower(follower)
+	}
+
+	// The group dissolves along with its leader
+	ch.AffectedBy &= ^types.AFF_GROUP
+}
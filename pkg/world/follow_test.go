@@ -0,0 +1,60 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+func TestCircleFollow(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	room := &types.Room{VNUM: 1}
+	leader := &types.Character{Name: "Leader", World: world}
+	follower := &types.Character{Name: "Follower", World: world}
+	second := &types.Character{Name: "Second", World: world}
+	other := &types.Character{Name: "Other", World: world}
+	for _, ch := range []*types.Character{leader, follower, second, other} {
+		world.CharacterMove(ch, room)
+	}
+
+	world.AddFollower(follower, leader)
+	world.AddFollower(second, follower)
+	world.AddFollower(other, leader)
+
+	if !world.CircleFollow(leader, second) {
+		t.Error("Expected leader following second to be a loop")
+	}
+	if world.CircleFollow(second, other) {
+		t.Error("Expected second following other not to be a loop")
+	}
+}
+
+func TestDieFollowerReleasesGroup(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	room := &types.Room{VNUM: 1}
+	leader := &types.Character{Name: "Leader", World: world}
+	member := &types.Character{Name: "Member", World: world}
+	world.CharacterMove(leader, room)
+	world.CharacterMove(member, room)
+
+	world.AddFollower(member, leader)
+	leader.AffectedBy |= types.AFF_GROUP
+	member.AffectedBy |= types.AFF_GROUP
+
+	world.DieFollower(leader)
+
+	if member.Following != nil || len(leader.Followers) != 0 {
+		t.Errorf("Expected following to be cleared, got %v and %d followers", member.Following, len(leader.Followers))
+	}
+	if member.AffectedBy&types.AFF_GROUP != 0 || leader.AffectedBy&types.AFF_GROUP != 0 {
+		t.Error("Expected group flags to be cleared")
+	}
+}
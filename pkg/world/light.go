@@ -0,0 +1,87 @@
+package world
+
+import (
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// IsDark returns true if there isn't enough light in the room to see by.
+// With no light burning, dark rooms are always dark and rooms outdoors are
+// dark from sunset until sunrise.
+func (w *World) IsDark(room *types.Room) bool {
+	if room == nil || room.Light > 0 {
+		return false
+	}
+
+	if room.Flags&types.ROOM_DARK != 0 {
+		return true
+	}
+
+	return isOutdoors(room) && (w.time.Sunlight == types.SUN_SET || w.time.Sunlight == types.SUN_DARK)
+}
+
+// CanSeeInDark returns true if ch can make out the room in the dark
+func (w *World) CanSeeInDark(ch *types.Character) bool {
+	return hasHolylight(ch) || ch.AffectedBy&types.AFF_INFRAVISION != 0
+}
+
+// isOutdoors returns true if the sun lights the room
+func isOutdoors(room *types.Room) bool {
+	return room.Flags&types.ROOM_INDOORS == 0 && room.SectorType != types.SECT_INSIDE && room.SectorType != types.SECT_CITY
+}
+
+// UpdateRoomLight recounts the lights burning in a room: lights held in the
+// light slot by those there and lights lying on the floor. It is called
+// whenever a light may have come, gone or burned out.
+func (w *World) UpdateRoomLight(room *types.Room) {
+	if room == nil {
+		return
+	}
+
+	room.Lock()
+	defer room.Unlock()
+
+	light := 0
+	for _, ch := range room.Characters {
+		if len(ch.Equipment) > types.WEAR_LIGHT && ch.Equipment[types.WEAR_LIGHT] != nil && ch.Equipment[types.WEAR_LIGHT].IsBurningLight() {
+			light++
+		}
+	}
+	for _, obj := range room.Objects {
+		if obj.IsBurningLight() {
+			light++
+		}
+	}
+	room.Light = light
+}
+
+// burnLights burns the lights players hold down by an hour, warning them
+// when a light is about to go out and putting it out when it runs out
+func (w *World) burnLights() {
+	w.mutex.RLock()
+	characters := make([]*types.Character, 0, len(w.characters))
+	for _, ch := range w.characters {
+		if !ch.IsNPC {
+			characters = append(characters, ch)
+		}
+	}
+	w.mutex.RUnlock()
+
+	for _, ch := range characters {
+		if len(ch.Equipment) <= types.WEAR_LIGHT {
+			continue
+		}
+		light := ch.Equipment[types.WEAR_LIGHT]
+		if light == nil || !light.IsBurningLight() || light.LightHours() < 0 {
+			continue
+		}
+
+		switch hours := light.BurnLight(); {
+		case hours == 0:
+			w.Act("Your light sputters out and dies.", false, ch, nil, nil, types.TO_CHAR)
+			w.Act("$n's light sputters out and dies.", false, ch, nil, nil, types.TO_ROOM)
+			w.UpdateRoomLight(ch.InRoom)
+		case hours <= 2:
+			w.Act("Your light begins to flicker and fade.", false, ch, nil, nil, types.TO_CHAR)
+		}
+	}
+}
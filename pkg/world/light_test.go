@@ -0,0 +1,156 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+func TestUpdateRoomLightCountsBurningLights(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	room := &types.Room{VNUM: 1, Flags: types.ROOM_DARK}
+	holder := &types.Character{Name: "Holder", InRoom: room, Equipment: make([]*types.ObjectInstance, types.NUM_WEARS)}
+	room.Characters = []*types.Character{holder}
+
+	lantern := &types.Object{Name: "lantern", Type: types.ITEM_LIGHT, Value: [4]int{0, 0, 5, 0}}
+	holder.Equipment[types.WEAR_LIGHT] = &types.ObjectInstance{Prototype: lantern}
+	room.Objects = []*types.ObjectInstance{
+		{Prototype: lantern},
+		{Prototype: lantern, Value: [4]int{0, 0, 5, 0}},
+		{Prototype: &types.Object{Name: "sword", Type: types.ITEM_WEAPON}},
+	}
+
+	world.UpdateRoomLight(room)
+	if room.Light != 2 {
+		t.Errorf("Expected 2 lights burning, got %d", room.Light)
+	}
+	if world.IsDark(room) {
+		t.Error("Expected the lights to light up the dark room")
+	}
+
+	holder.Equipment[types.WEAR_LIGHT] = nil
+	room.Objects = room.Objects[1:]
+	world.UpdateRoomLight(room)
+	if room.Light != 0 || !world.IsDark(room) {
+		t.Errorf("Expected the room to go dark, got %d lights", room.Light)
+	}
+}
+
+func TestCarryingLightOutDarkensRoom(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	cave := &types.Room{VNUM: 1, Flags: types.ROOM_DARK}
+	tunnel := &types.Room{VNUM: 2, Flags: types.ROOM_DARK}
+	holder := &types.Character{Name: "Holder", Equipment: make([]*types.ObjectInstance, types.NUM_WEARS)}
+	holder.Equipment[types.WEAR_LIGHT] = &types.ObjectInstance{
+		Prototype: &types.Object{Name: "lantern", Type: types.ITEM_LIGHT, Value: [4]int{0, 0, 5, 0}},
+	}
+
+	world.CharToRoom(holder, cave)
+	if cave.Light != 1 {
+		t.Fatalf("Expected the lantern to light the cave, got %d lights", cave.Light)
+	}
+
+	// Spells like teleport move characters this way
+	world.CharFromRoom(holder)
+	world.CharToRoom(holder, tunnel)
+	if cave.Light != 0 || !world.IsDark(cave) {
+		t.Errorf("Expected the cave to go dark, got %d lights", cave.Light)
+	}
+	if tunnel.Light != 1 {
+		t.Errorf("Expected the lantern to light the tunnel, got %d lights", tunnel.Light)
+	}
+}
+
+func TestPulseTimeBurnsOutLights(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	received := make(map[*types.Character][]string)
+	world.SetMessageHandler(func(ch *types.Character, message string) {
+		received[ch] = append(received[ch], message)
+	})
+
+	room := &types.Room{VNUM: 1, Flags: types.ROOM_DARK | types.ROOM_INDOORS}
+	holder := &types.Character{Name: "Holder", Position: types.POS_STANDING, InRoom: room, World: world,
+		Equipment: make([]*types.ObjectInstance, types.NUM_WEARS)}
+	watcher := &types.Character{Name: "Watcher", Position: types.POS_STANDING, InRoom: room, World: world,
+		AffectedBy: types.AFF_INFRAVISION}
+	room.Characters = []*types.Character{holder, watcher}
+	world.characters[holder.Name] = holder
+	world.characters[watcher.Name] = watcher
+
+	torch := &types.ObjectInstance{Prototype: &types.Object{Name: "torch", Type: types.ITEM_LIGHT, Value: [4]int{0, 0, 3, 0}}}
+	holder.Equipment[types.WEAR_LIGHT] = torch
+	world.UpdateRoomLight(room)
+
+	world.PulseTime()
+	if torch.LightHours() != 2 {
+		t.Errorf("Expected 2 hours left, got %d", torch.LightHours())
+	}
+	if len(received[holder]) != 1 || received[holder][0] != "Your light begins to flicker and fade.\r\n" {
+		t.Errorf("Expected the holder to be warned, got %q", received[holder])
+	}
+
+	world.PulseTime()
+	received = make(map[*types.Character][]string)
+	world.PulseTime()
+	if torch.LightHours() != 0 || torch.IsBurningLight() {
+		t.Errorf("Expected the torch to burn out, got %d hours left", torch.LightHours())
+	}
+	if len(received[holder]) != 1 || received[holder][0] != "Your light sputters out and dies.\r\n" {
+		t.Errorf("Expected the holder to see the light die, got %q", received[holder])
+	}
+	if len(received[watcher]) != 1 || received[watcher][0] != "Holder's light sputters out and dies.\r\n" {
+		t.Errorf("Expected the watcher to see the light die, got %q", received[watcher])
+	}
+	if room.Light != 0 {
+		t.Errorf("Expected the room to go dark, got %d lights", room.Light)
+	}
+
+	received = make(map[*types.Character][]string)
+	world.PulseTime()
+	if len(received[holder]) != 0 {
+		t.Errorf("Expected a burnt out light to stay quiet, got %q", received[holder])
+	}
+}
+
+func TestNightDarkensRoomsOutdoors(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	field := &types.Room{VNUM: 1, SectorType: types.SECT_FIELD}
+	street := &types.Room{VNUM: 2, SectorType: types.SECT_CITY}
+	viewer := &types.Character{Name: "Alice", InRoom: field}
+	other := &types.Character{Name: "Bob", InRoom: field}
+	field.Characters = []*types.Character{viewer, other}
+
+	world.time.Sunlight = types.SUN_LIGHT
+	if world.IsDark(field) {
+		t.Error("Expected daylight outdoors")
+	}
+
+	world.time.Sunlight = types.SUN_DARK
+	if !world.IsDark(field) || world.CanSee(viewer, other) {
+		t.Error("Expected night to darken the field")
+	}
+	if world.IsDark(street) {
+		t.Error("Expected the city streets to stay lit at night")
+	}
+
+	viewer.AffectedBy |= types.AFF_INFRAVISION
+	if !world.CanSee(viewer, other) || !world.CanSeeInDark(viewer) {
+		t.Error("Expected infravision to see in the dark")
+	}
+}
@@ -0,0 +1,66 @@
+package world
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// SendMail leaves a letter at the post office for a player
+func (w *World) SendMail(from, to, text string) error {
+	w.mailMutex.Lock()
+	defer w.mailMutex.Unlock()
+
+	name := strings.ToLower(to)
+	mail, err := w.storage.LoadPlayerMail(name)
+	if err != nil {
+		return fmt.Errorf("failed to load mail: %w", err)
+	}
+
+	mail = append(mail, &types.MailMessage{
+		From: from,
+		To:   to,
+		Text: text,
+		Sent: time.Now(),
+	})
+
+	return w.storage.SavePlayerMail(name, mail)
+}
+
+// HasMail returns true if a player has mail waiting
+func (w *World) HasMail(name string) bool {
+	w.mailMutex.Lock()
+	defer w.mailMutex.Unlock()
+
+	mail, err := w.storage.LoadPlayerMail(strings.ToLower(name))
+	if err != nil {
+		log.Printf("Error loading mail for %s: %v", name, err)
+		return false
+	}
+	return len(mail) > 0
+}
+
+// ReceiveMail hands over the mail waiting for a player, oldest first, and
+// removes it from the post office
+func (w *World) ReceiveMail(name string) ([]*types.MailMessage, error) {
+	w.mailMutex.Lock()
+	defer w.mailMutex.Unlock()
+
+	name = strings.ToLower(name)
+	mail, err := w.storage.LoadPlayerMail(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load mail: %w", err)
+	}
+	if len(mail) == 0 {
+		return nil, nil
+	}
+
+	if err := w.storage.SavePlayerMail(name, nil); err != nil {
+		return nil, fmt.Errorf("failed to clear mail: %w", err)
+	}
+
+	return mail, nil
+}
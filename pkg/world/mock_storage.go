@@ -16,6 +16,7 @@ type MockStorage struct {
 	characters map[string]*types.Character
 	chars      map[string]*types.Character // Alias for characters for backward compatibility
 	charObjs   map[string][]*types.ObjectInstance
+	charMail   map[string][]*types.MailMessage
 }
 
 // NewMockStorage creates a new mock storage
@@ -30,6 +31,7 @@ func NewMockStorage() *MockStorage {
 		characters: characters,
 		chars:      characters, // Alias for characters
 		charObjs:   make(map[string][]*types.ObjectInstance),
+		charMail:   make(map[string][]*types.MailMessage),
 	}
 }
 
@@ -107,3 +109,14 @@ func (s *MockStorage) SavePlayerObjects(name string, objects []*types.ObjectInst
 	s.charObjs[name] = objects
 	return nil
 }
+
+// LoadPlayerMail returns mock player mail
+func (s *MockStorage) LoadPlayerMail(name string) ([]*types.MailMessage, error) {
+	return s.charMail[name], nil
+}
+
+// SavePlayerMail saves mock player mail
+func (s *MockStorage) SavePlayerMail(name string, mail []*types.MailMessage) error {
+	s.charMail[name] = mail
+	return nil
+}
@@ -0,0 +1,154 @@
+package world
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// secsPerRealDay is the length of the period rent is charged for
+const secsPerRealDay = 24 * 60 * 60
+
+// RentCharacter stores everything the character carries and wears with the
+// receptionist at the given cost per day. The character is left empty handed
+// and should be logged out afterwards. If the objects can't be saved the
+// character keeps them.
+func (w *World) RentCharacter(ch *types.Character, cost int) error {
+	var objects []*types.ObjectInstance
+
+	for i, obj := range ch.Equipment {
+		if obj == nil {
+			continue
+		}
+		obj.WornOn = i
+		objects = append(objects, obj)
+	}
+
+	for _, obj := range ch.Inventory {
+		obj.WornOn = -1
+		objects = append(objects, obj)
+	}
+
+	if err := w.storage.SavePlayerObjects(ch.Name, objects); err != nil {
+		return fmt.Errorf("failed to save rented objects: %w", err)
+	}
+
+	for i, obj := range ch.Equipment {
+		if obj == nil {
+			continue
+		}
+		w.ApplyObjectAffects(ch, obj, false)
+		obj.WornBy = nil
+		obj.CarriedBy = nil
+		ch.Equipment[i] = nil
+	}
+
+	for _, obj := range ch.Inventory {
+		obj.CarriedBy = nil
+	}
+	ch.Inventory = make([]*types.ObjectInstance, 0)
+
+	ch.RentTime = time.Now()
+	ch.RentCost = cost
+
+	return nil
+}
+
+// DropAllObjects drops everything the character carries and wears on the
+// floor of their room. Characters who quit instead of renting leave their
+// objects behind this way.
+func (w *World) DropAllObjects(ch *types.Character) {
+	room := ch.InRoom
+	if room == nil {
+		return
+	}
+
+	for i, obj := range ch.Equipment {
+		if obj == nil {
+			continue
+		}
+		w.ApplyObjectAffects(ch, obj, false)
+		ch.Equipment[i] = nil
+		obj.WornBy = nil
+		obj.WornOn = -1
+		ch.Inventory = append(ch.Inventory, obj)
+	}
+
+	room.Lock()
+	for _, obj := range ch.Inventory {
+		obj.CarriedBy = nil
+		obj.InRoom = room
+		room.Objects = append(room.Objects, obj)
+	}
+	room.Unlock()
+	ch.Inventory = make([]*types.ObjectInstance, 0)
+
+	w.UpdateRoomLight(room)
+}
+
+// ReturnRentedObjects gives a character who rented back their objects and
+// charges the rent for the time they were away. A character who can't pay
+// loses everything they left with the receptionist.
+func (w *World) ReturnRentedObjects(ch *types.Character) {
+	if ch.RentTime.IsZero() {
+		return
+	}
+
+	objects, err := w.storage.LoadPlayerObjects(ch.Name)
+	if err != nil {
+		log.Printf("Error loading rented objects for %s: %v", ch.Name, err)
+		return
+	}
+
+	bill := int(float64(ch.RentCost) * time.Since(ch.RentTime).Seconds() / secsPerRealDay)
+	if bill > ch.Gold {
+		ch.SendMessage("You could not afford your rent!\r\nYour possesions have been donated to the Salvation Army!\r\n")
+	} else {
+		ch.Gold -= bill
+		for _, obj := range objects {
+			w.returnRentedObject(ch, obj)
+		}
+	}
+
+	ch.RentTime = time.Time{}
+	ch.RentCost = 0
+
+	if err := w.storage.SavePlayerObjects(ch.Name, nil); err != nil {
+		log.Printf("Error clearing rented objects for %s: %v", ch.Name, err)
+	}
+}
+
+// returnRentedObject puts a rented object back where the character had it
+func (w *World) returnRentedObject(ch *types.Character, obj *types.ObjectInstance) {
+	w.relinkPrototypes(obj)
+
+	if len(ch.Equipment) < types.NUM_WEARS {
+		equipment := make([]*types.ObjectInstance, types.NUM_WEARS)
+		copy(equipment, ch.Equipment)
+		ch.Equipment = equipment
+	}
+
+	obj.CarriedBy = ch
+	if obj.WornOn >= 0 && obj.WornOn < len(ch.Equipment) && ch.Equipment[obj.WornOn] == nil {
+		obj.WornBy = ch
+		ch.Equipment[obj.WornOn] = obj
+		w.ApplyObjectAffects(ch, obj, true)
+		return
+	}
+
+	obj.WornOn = -1
+	ch.Inventory = append(ch.Inventory, obj)
+}
+
+// relinkPrototypes points a loaded object and its contents back at the
+// world's prototypes, which may have changed while the character was away
+func (w *World) relinkPrototypes(obj *types.ObjectInstance) {
+	if proto := w.GetObjectPrototype(obj.Prototype.VNUM); proto != nil {
+		obj.Prototype = proto
+	}
+	for _, content := range obj.Contains {
+		w.relinkPrototypes(content)
+	}
+}
@@ -0,0 +1,148 @@
+package world
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+func TestRentAndReturnObjects(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	player := &types.Character{
+		Name:      "Renter",
+		Gold:      1000,
+		Equipment: make([]*types.ObjectInstance, types.NUM_WEARS),
+		World:     world,
+	}
+	sword := &types.ObjectInstance{Prototype: &types.Object{VNUM: 1001, Name: "sword"}, WornBy: player, WornOn: types.WEAR_WIELD}
+	bag := &types.ObjectInstance{Prototype: &types.Object{VNUM: 1002, Name: "bag"}, CarriedBy: player}
+	player.Equipment[types.WEAR_WIELD] = sword
+	player.Inventory = []*types.ObjectInstance{bag}
+
+	if err := world.RentCharacter(player, 240); err != nil {
+		t.Fatalf("Failed to rent: %v", err)
+	}
+	if len(player.Inventory) != 0 || player.Equipment[types.WEAR_WIELD] != nil {
+		t.Fatal("Expected the renter to be left empty handed")
+	}
+
+	// Half a day away costs half the daily rent
+	player.RentTime = time.Now().Add(-12 * time.Hour)
+	world.ReturnRentedObjects(player)
+
+	if player.Gold != 1000-120 {
+		t.Errorf("Expected 880 gold left, got %d", player.Gold)
+	}
+	if player.Equipment[types.WEAR_WIELD] != sword || sword.WornBy != player {
+		t.Error("Expected the sword to be wielded again")
+	}
+	if len(player.Inventory) != 1 || player.Inventory[0] != bag {
+		t.Error("Expected the bag back in the inventory")
+	}
+	if !player.RentTime.IsZero() || player.RentCost != 0 {
+		t.Error("Expected the rent to be settled")
+	}
+}
+
+func TestUnpaidRentConfiscatesObjects(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	player := &types.Character{Name: "Debtor", Gold: 50, World: world}
+	player.Inventory = []*types.ObjectInstance{{Prototype: &types.Object{VNUM: 1002, Name: "bag"}}}
+
+	if err := world.RentCharacter(player, 100); err != nil {
+		t.Fatalf("Failed to rent: %v", err)
+	}
+
+	player.RentTime = time.Now().Add(-48 * time.Hour)
+	world.ReturnRentedObjects(player)
+
+	if len(player.Inventory) != 0 {
+		t.Error("Expected the objects to be confiscated")
+	}
+	if player.Gold != 50 {
+		t.Errorf("Expected the gold to be untouched, got %d", player.Gold)
+	}
+	if !player.RentTime.IsZero() {
+		t.Error("Expected the rent to be settled")
+	}
+}
+
+// failingObjectStorage is a mock storage that can't save player objects
+type failingObjectStorage struct {
+	*MockStorage
+}
+
+func (s failingObjectStorage) SavePlayerObjects(name string, objects []*types.ObjectInstance) error {
+	return fmt.Errorf("disk full")
+}
+
+func TestFailedRentKeepsObjects(t *testing.T) {
+	world, err := NewWorld(nil, failingObjectStorage{NewMockStorage()})
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	player := &types.Character{
+		Name:      "Renter",
+		Equipment: make([]*types.ObjectInstance, types.NUM_WEARS),
+		World:     world,
+	}
+	sword := &types.ObjectInstance{Prototype: &types.Object{VNUM: 1001, Name: "sword"}, WornBy: player, CarriedBy: player, WornOn: types.WEAR_WIELD}
+	bag := &types.ObjectInstance{Prototype: &types.Object{VNUM: 1002, Name: "bag"}, CarriedBy: player}
+	player.Equipment[types.WEAR_WIELD] = sword
+	player.Inventory = []*types.ObjectInstance{bag}
+
+	if err := world.RentCharacter(player, 240); err == nil {
+		t.Fatal("Expected the rent to fail")
+	}
+	if player.Equipment[types.WEAR_WIELD] != sword || sword.WornBy != player {
+		t.Error("Expected the sword to still be wielded")
+	}
+	if len(player.Inventory) != 1 || player.Inventory[0] != bag || bag.CarriedBy != player {
+		t.Error("Expected the bag to still be carried")
+	}
+	if !player.RentTime.IsZero() {
+		t.Error("Expected the character not to be renting")
+	}
+}
+
+func TestQuitterDropsObjects(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	room := &types.Room{VNUM: 3001}
+	player := &types.Character{
+		Name:      "Quitter",
+		Equipment: make([]*types.ObjectInstance, types.NUM_WEARS),
+		World:     world,
+	}
+	world.CharToRoom(player, room)
+	sword := &types.ObjectInstance{Prototype: &types.Object{VNUM: 1001, Name: "sword"}, WornBy: player, CarriedBy: player, WornOn: types.WEAR_WIELD}
+	bag := &types.ObjectInstance{Prototype: &types.Object{VNUM: 1002, Name: "bag"}, CarriedBy: player}
+	player.Equipment[types.WEAR_WIELD] = sword
+	player.Inventory = []*types.ObjectInstance{bag}
+
+	world.DropAllObjects(player)
+
+	if len(player.Inventory) != 0 || player.Equipment[types.WEAR_WIELD] != nil {
+		t.Error("Expected the quitter to be left empty handed")
+	}
+	if len(room.Objects) != 2 || sword.InRoom != room || bag.InRoom != room {
+		t.Error("Expected the sword and bag to be left in the room")
+	}
+	if sword.WornBy != nil || bag.CarriedBy != nil {
+		t.Error("Expected the objects to no longer belong to the quitter")
+	}
+}
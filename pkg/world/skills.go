@@ -51,6 +51,9 @@ func (w *World) InitializeNewCharacter(ch *types.Character) {
 	// Set alignment tendencies
 	ch.Alignment = startingAlignment
 
+	// Grant the practice sessions for the first level
+	w.gainPractices(ch)
+
 	// Initialize skills
 	w.InitializeCharacterSkills(ch)
 
@@ -0,0 +1,134 @@
+package world
+
+import (
+	"fmt"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// spellHandler is the signature shared by the World's Cast methods
+type spellHandler func(w *World, level int, ch *types.Character, arg string, spellType int, victim *types.Character, obj *types.ObjectInstance) error
+
+// spellHandlers maps each implemented spell to the method that casts it
+var spellHandlers = map[int]spellHandler{
+	types.SPELL_ARMOR:                (*World).CastArmor,
+	types.SPELL_TELEPORT:             (*World).CastTeleport,
+	types.SPELL_BLESS:                (*World).CastBless,
+	types.SPELL_BLINDNESS:            (*World).CastBlindness,
+	types.SPELL_BURNING_HANDS:        (*World).CastBurningHands,
+	types.SPELL_CALL_LIGHTNING:       (*World).CastCallLightning,
+	types.SPELL_CHARM_PERSON:         (*World).CastCharmPerson,
+	types.SPELL_CHILL_TOUCH:          (*World).CastChillTouch,
+	types.SPELL_CLONE:                (*World).CastClone,
+	types.SPELL_COLOR_SPRAY:          (*World).CastColorSpray,
+	types.SPELL_CONTROL_WEATHER:      (*World).CastControlWeather,
+	types.SPELL_CREATE_FOOD:          (*World).CastCreateFood,
+	types.SPELL_CREATE_WATER:         (*World).CastCreateWater,
+	types.SPELL_CURE_BLIND:           (*World).CastCureBlindness,
+	types.SPELL_CURE_CRITIC:          (*World).CastCureCritic,
+	types.SPELL_CURE_LIGHT:           (*World).CastCureLight,
+	types.SPELL_CURSE:                (*World).CastCurse,
+	types.SPELL_DETECT_EVIL:          (*World).CastDetectEvil,
+	types.SPELL_DETECT_INVISIBLE:     (*World).CastDetectInvisible,
+	types.SPELL_DETECT_MAGIC:         (*World).CastDetectMagic,
+	types.SPELL_DETECT_POISON:        (*World).CastDetectPoison,
+	types.SPELL_DISPEL_EVIL:          (*World).CastDispelEvil,
+	types.SPELL_EARTHQUAKE:           (*World).CastEarthquake,
+	types.SPELL_ENCHANT_WEAPON:       (*World).CastEnchantWeapon,
+	types.SPELL_ENERGY_DRAIN:         (*World).CastEnergyDrain,
+	types.SPELL_FIREBALL:             (*World).CastFireball,
+	types.SPELL_HARM:                 (*World).CastHarm,
+	types.SPELL_HEAL:                 (*World).CastHeal,
+	types.SPELL_INVISIBLE:            (*World).CastInvisible,
+	types.SPELL_LIGHTNING_BOLT:       (*World).CastLightningBolt,
+	types.SPELL_LOCATE_OBJECT:        (*World).CastLocateObject,
+	types.SPELL_MAGIC_MISSILE:        (*World).CastMagicMissile,
+	types.SPELL_POISON:               (*World).CastPoison,
+	types.SPELL_PROTECTION_FROM_EVIL: (*World).CastProtectionFromEvil,
+	types.SPELL_REMOVE_CURSE:         (*World).CastRemoveCurse,
+	types.SPELL_SANCTUARY:            (*World).CastSanctuary,
+	types.SPELL_SHOCKING_GRASP:       (*World).CastShockingGrasp,
+	types.SPELL_SLEEP:                (*World).CastSleep,
+	types.SPELL_STRENGTH:             (*World).CastStrength,
+	types.SPELL_SUMMON:               (*World).CastSummon,
+	types.SPELL_VENTRILOQUATE:        (*World).CastVentriloquate,
+	types.SPELL_WORD_OF_RECALL:       (*World).CastWordOfRecall,
+	types.SPELL_REMOVE_POISON:        (*World).CastRemovePoison,
+	types.SPELL_SENSE_LIFE:           (*World).CastSenseLife,
+	types.SPELL_IDENTIFY:             (*World).CastIdentify,
+	types.SPELL_FIRE_BREATH:          (*World).CastFireBreath,
+	types.SPELL_GAS_BREATH:           (*World).CastGasBreath,
+	types.SPELL_FROST_BREATH:         (*World).CastFrostBreath,
+	types.SPELL_ACID_BREATH:          (*World).CastAcidBreath,
+	types.SPELL_LIGHTNING_BREATH:     (*World).CastLightningBreath,
+}
+
+func init() {
+	for id, handler := range spellHandlers {
+		if info, ok := types.SpellData[id]; ok {
+			info.Cast = wrapSpellHandler(handler)
+		}
+	}
+}
+
+// wrapSpellHandler adapts a World method to the types.SpellFunc signature
+func wrapSpellHandler(handler spellHandler) types.SpellFunc {
+	return func(world interface{}, level int, ch *types.Character, arg string, spellType int, victim *types.Character, obj *types.ObjectInstance) error {
+		w, ok := world.(*World)
+		if !ok {
+			return fmt.Errorf("world interface not available")
+		}
+		return handler(w, level, ch, arg, spellType, victim, obj)
+	}
+}
+
+// CastSpell casts a spell through its entry in the spell table. Spells,
+// potions, scrolls, wands and staves all come through here.
+func (w *World) CastSpell(spell, level int, ch *types.Character, arg string, spellType int, victim *types.Character, obj *types.ObjectInstance) error {
+	info, ok := types.SpellData[spell]
+	if !ok || info.Cast == nil {
+		return fmt.Errorf("sorry, this magic has not yet been implemented")
+	}
+	if err := checkSpellVictim(ch, victim, spell); err != nil {
+		return err
+	}
+	if err := info.Cast(w, level, ch, arg, spellType, victim, obj); err != nil {
+		return err
+	}
+
+	// A victim who lives through a violent spell fights back
+	if info.Violent {
+		startSpellFight(ch, victim)
+	}
+	return nil
+}
+
+// checkSpellVictim refuses a violent spell aimed at someone who can't be
+// attacked. As with kill, players can only turn violent magic on NPCs.
+func checkSpellVictim(ch, victim *types.Character, spell int) error {
+	if !types.IsSpellViolent(spell) || victim == nil || victim == ch {
+		return nil
+	}
+	if !ch.IsNPC && !victim.IsNPC {
+		return fmt.Errorf("you can't attack other players")
+	}
+	return nil
+}
+
+// startSpellFight sets the caster and the victim of a violent spell
+// fighting each other, unless either is already busy with someone else.
+// A victim the spell put to sleep or charmed doesn't fight back.
+func startSpellFight(ch, victim *types.Character) {
+	if victim == nil || victim == ch || victim.InRoom != ch.InRoom {
+		return
+	}
+	if victim.Position <= types.POS_SLEEPING || victim.AffectedBy&types.AFF_CHARM != 0 {
+		return
+	}
+	if ch.Fighting == nil {
+		ch.Fighting = victim
+	}
+	if victim.Fighting == nil {
+		victim.Fighting = ch
+	}
+}
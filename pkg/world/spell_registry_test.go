@@ -0,0 +1,153 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+func TestSpellTableHandlers(t *testing.T) {
+	for _, info := range types.SpellData {
+		if info.Cast == nil {
+			t.Errorf("Expected %s to have a handler", info.Name)
+		}
+	}
+
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	mage := &types.Character{Name: "Mage", Level: 10, Class: types.CLASS_MAGIC_USER, World: world}
+	if err := world.CastSpell(types.SPELL_UNDEFINED, 10, mage, "", types.SPELL_TYPE_SPELL, mage, nil); err == nil {
+		t.Error("Expected an unknown spell to be reported as not yet implemented")
+	}
+}
+
+func TestSpellManaCost(t *testing.T) {
+	// Armor is a first level cleric spell costing at least 5 mana
+	if mana := types.GetSpellMana(types.SPELL_ARMOR, 1, types.CLASS_CLERIC); mana != 50 {
+		t.Errorf("Expected a newly learned spell to cost 50 mana, got %d", mana)
+	}
+	if mana := types.GetSpellMana(types.SPELL_ARMOR, 10, types.CLASS_CLERIC); mana != 9 {
+		t.Errorf("Expected the cost to fall as the caster grows, got %d", mana)
+	}
+	if mana := types.GetSpellMana(types.SPELL_ARMOR, 30, types.CLASS_CLERIC); mana != 5 {
+		t.Errorf("Expected the cost never to drop below the minimum, got %d", mana)
+	}
+}
+
+func TestBlessWearsOff(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	var received []string
+	world.SetMessageHandler(func(ch *types.Character, message string) {
+		received = append(received, message)
+	})
+
+	room := &types.Room{VNUM: 1, Flags: types.ROOM_INDOORS}
+	cleric := &types.Character{Name: "Cleric", Level: 10, Class: types.CLASS_CLERIC, Position: types.POS_STANDING, World: world,
+		Equipment: make([]*types.ObjectInstance, types.NUM_WEARS)}
+	world.CharacterMove(cleric, room)
+	world.AddCharacter(cleric)
+
+	if err := world.CastSpell(types.SPELL_BLESS, 10, cleric, "", types.SPELL_TYPE_SPELL, cleric, nil); err != nil {
+		t.Fatalf("Expected bless to be cast, got %v", err)
+	}
+	if !world.AffectedBySpell(cleric, types.SPELL_BLESS) {
+		t.Fatal("Expected the cleric to be blessed")
+	}
+
+	for af := cleric.Affected; af != nil; af = af.Next {
+		af.Duration = 1
+	}
+	received = nil
+	world.PulseAffectUpdate()
+
+	if world.AffectedBySpell(cleric, types.SPELL_BLESS) {
+		t.Error("Expected the bless to have worn off")
+	}
+	if len(received) != 1 || received[0] != "You feel less righteous.\r\n" {
+		t.Errorf("Expected a single bless wear-off message, got %q", received)
+	}
+}
+
+func TestSpellTargetsFollowTheTable(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	room := &types.Room{VNUM: 1, Flags: types.ROOM_INDOORS}
+	mage := &types.Character{Name: "Mage", Level: 10, Class: types.CLASS_MAGIC_USER, Position: types.POS_STANDING, World: world}
+	rabbit := &types.Character{Name: "rabbit", ShortDesc: "a rabbit", Level: 1, IsNPC: true, Position: types.POS_STANDING, World: world}
+	world.CharacterMove(mage, room)
+	world.CharacterMove(rabbit, room)
+
+	// Spells that need no target get none
+	if victim, obj, err := world.GetSpellTarget(mage, "", types.SPELL_EARTHQUAKE); err != nil || victim != nil || obj != nil {
+		t.Errorf("Expected earthquake to need no target, got %v, %v, %v", victim, obj, err)
+	}
+
+	// A violent spell never falls back on the caster
+	if _, _, err := world.GetSpellTarget(mage, "", types.SPELL_MAGIC_MISSILE); err == nil {
+		t.Error("Expected magic missile with no target and no fight to be refused")
+	}
+	mage.Fighting = rabbit
+	if victim, _, err := world.GetSpellTarget(mage, "", types.SPELL_MAGIC_MISSILE); err != nil || victim != rabbit {
+		t.Errorf("Expected magic missile to go for the rabbit being fought, got %v, %v", victim, err)
+	}
+	mage.Fighting = nil
+
+	// Others still land on the caster
+	if victim, _, err := world.GetSpellTarget(mage, "", types.SPELL_ARMOR); err != nil || victim != mage {
+		t.Errorf("Expected armor to land on the caster, got %v, %v", victim, err)
+	}
+}
+
+func TestViolentSpellsStartFights(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	room := &types.Room{VNUM: 1, Flags: types.ROOM_INDOORS}
+	mage := &types.Character{Name: "Mage", Level: 10, Class: types.CLASS_MAGIC_USER, Position: types.POS_STANDING, World: world}
+	player := &types.Character{Name: "Bob", Level: 10, HP: 100, MaxHitPoints: 100, Position: types.POS_STANDING, World: world}
+	ogre := &types.Character{Name: "ogre", ShortDesc: "an ogre", Level: 10, HP: 500, MaxHitPoints: 500, IsNPC: true,
+		Position: types.POS_STANDING, World: world, Equipment: make([]*types.ObjectInstance, types.NUM_WEARS)}
+	world.CharacterMove(mage, room)
+	world.CharacterMove(player, room)
+	world.CharacterMove(ogre, room)
+
+	// Players are refused as victims, the same as with kill
+	if _, _, err := world.GetSpellTarget(mage, "bob", types.SPELL_MAGIC_MISSILE); err == nil {
+		t.Error("Expected magic missile at another player to be refused")
+	}
+	if err := world.CastSpell(types.SPELL_MAGIC_MISSILE, 10, mage, "", types.SPELL_TYPE_WAND, player, nil); err == nil {
+		t.Error("Expected a wand of magic missile at another player to be refused")
+	}
+	if player.HP != 100 || player.Fighting != nil {
+		t.Error("Expected the player to be left alone")
+	}
+
+	// NPCs fight back
+	if err := world.CastSpell(types.SPELL_MAGIC_MISSILE, 10, mage, "", types.SPELL_TYPE_SCROLL, ogre, nil); err != nil {
+		t.Fatalf("Expected magic missile at the ogre to be cast, got %v", err)
+	}
+	if mage.Fighting != ogre || ogre.Fighting != mage {
+		t.Error("Expected the mage and the ogre to be fighting")
+	}
+
+	// Peaceful spells don't start anything
+	mage.Fighting, ogre.Fighting = nil, nil
+	if err := world.CastSpell(types.SPELL_ARMOR, 10, mage, "", types.SPELL_TYPE_SPELL, ogre, nil); err != nil {
+		t.Fatalf("Expected armor on the ogre to be cast, got %v", err)
+	}
+	if mage.Fighting != nil || ogre.Fighting != nil {
+		t.Error("Expected armor not to start a fight")
+	}
+}
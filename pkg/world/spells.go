@@ -202,16 +202,16 @@ func (w *World) SpellDamageChar(ch *types.Character, victim *types.Character, da
 		victim.SendMessage("You partially resist the spell.\r\n")
 	}
 
-	// Get damage message
-	message := w.DamageMessage(damage, spell)
-
-	// Send messages
-	w.Act(message, false, ch, nil, victim, types.TO_CHAR)
-	w.Act("$n's spell hits you!", false, ch, nil, victim, types.TO_VICT)
-	w.Act("$n's spell hits $N!", false, ch, nil, victim, types.TO_NOTVICT)
+	// Spells the messages file doesn't cover get a plainer message
+	attackType := types.SpellAttackType(spell)
+	if !w.HasAttackMessages(attackType) {
+		w.Act(w.DamageMessage(damage, spell), false, ch, nil, victim, types.TO_CHAR)
+		w.Act("$n's spell hits you!", false, ch, nil, victim, types.TO_VICT)
+		w.Act("$n's spell hits $N!", false, ch, nil, victim, types.TO_NOTVICT)
+	}
 
 	// Apply damage
-	w.Damage(ch, victim, damage, spell)
+	w.Damage(ch, victim, damage, attackType)
 }
 
 // SaySpell makes a character say the spell words
@@ -241,16 +241,31 @@ func (w *World) SaySpell(ch *types.Character, spell int) {
 	w.Act("$n utters the words, '"+spellName+"'", false, ch, nil, nil, types.TO_ROOM)
 }
 
-// GetSpellTarget finds a target for a spell
+// GetSpellTarget finds a target for a spell. Spells that name no target
+// need none, and with no argument a violent spell goes for whoever the
+// caster is fighting rather than the caster.
 func (w *World) GetSpellTarget(ch *types.Character, arg string, spell int) (*types.Character, *types.ObjectInstance, error) {
 	// Get spell info
 	targets := types.GetSpellTargets(spell)
 	if targets == 0 {
 		return nil, nil, fmt.Errorf("spell has no valid targets")
 	}
+	if targets&types.TAR_IGNORE != 0 {
+		return nil, nil, nil
+	}
+
+	// If no argument, a spell used in a fight finds its own target
+	if arg == "" && ch.Fighting != nil {
+		if targets&types.TAR_FIGHT_SELF != 0 {
+			return ch, nil, nil
+		}
+		if targets&types.TAR_FIGHT_VICT != 0 {
+			return ch.Fighting, nil, nil
+		}
+	}
 
 	// If no argument and spell can target self, target self
-	if arg == "" && (targets&types.TAR_SELF_ONLY != 0 || targets&types.TAR_CHAR_ROOM != 0) {
+	if arg == "" && !types.IsSpellViolent(spell) && (targets&types.TAR_SELF_ONLY != 0 || targets&types.TAR_CHAR_ROOM != 0) {
 		// Check if spell can't target self
 		if targets&types.TAR_SELF_NONO != 0 {
 			return nil, nil, fmt.Errorf("you cannot cast this spell on yourself")
@@ -267,9 +282,13 @@ func (w *World) GetSpellTarget(ch *types.Character, arg string, spell int) (*typ
 		}
 	}
 
+	// The target is named by the first word, anything after it is for
+	// the spell itself
+	arg = strings.Fields(arg)[0]
+
 	// Try to find a character target
 	if targets&types.TAR_CHAR_ROOM != 0 {
-		victim := w.GetCharacterInRoom(ch.InRoom, arg)
+		victim := w.GetVisibleCharacterInRoom(ch, arg)
 		if victim != nil {
 			// Check if spell can only target self
 			if targets&types.TAR_SELF_ONLY != 0 && victim != ch {
@@ -279,6 +298,9 @@ func (w *World) GetSpellTarget(ch *types.Character, arg string, spell int) (*typ
 			if targets&types.TAR_SELF_NONO != 0 && victim == ch {
 				return nil, nil, fmt.Errorf("you cannot cast this spell on yourself")
 			}
+			if err := checkSpellVictim(ch, victim, spell); err != nil {
+				return nil, nil, err
+			}
 			return victim, nil, nil
 		}
 	}
@@ -293,7 +315,7 @@ func (w *World) GetSpellTarget(ch *types.Character, arg string, spell int) (*typ
 
 	// Try to find an object target in room
 	if targets&types.TAR_OBJ_ROOM != 0 {
-		obj := w.GetObjectInRoom(ch.InRoom, arg)
+		obj := w.GetObjectInList(ch, arg, ch.InRoom.Objects)
 		if obj != nil {
 			return nil, obj, nil
 		}
@@ -311,11 +333,11 @@ func (w *World) GetSpellTarget(ch *types.Character, arg string, spell int) (*typ
 	return nil, nil, fmt.Errorf("nothing by that name here")
 }
 
-// GetObjectInList finds an object in a list by name
+// GetObjectInList finds an object ch can see in a list by name
 func (w *World) GetObjectInList(ch *types.Character, name string, list []*types.ObjectInstance) *types.ObjectInstance {
 	name = strings.ToLower(name)
 	for _, obj := range list {
-		if strings.Contains(strings.ToLower(obj.Prototype.Name), name) {
+		if strings.Contains(strings.ToLower(obj.Prototype.Name), name) && w.CanSeeObj(ch, obj) {
 			return obj
 		}
 	}
@@ -0,0 +1,168 @@
+package world
+
+import (
+	"fmt"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// breathDamage rolls the damage of a breath weapon, which grows with the
+// breather's hit points. Victims who save take half.
+func (w *World) breathDamage(ch, victim *types.Character) int {
+	hp := ch.HP
+	if hp < 10 {
+		hp = 10
+	}
+	damage := w.Random(hp/4 + 1)
+	if w.SavesSpell(victim, types.SAVING_BREATH) {
+		damage /= 2
+	}
+	return damage
+}
+
+// breathReachesItems returns true if a breath is strong enough to get at
+// what the victim carries. Stronger breathers get through more often, and
+// a victim who saves keeps their things safe.
+func (w *World) breathReachesItems(level int, victim *types.Character) bool {
+	if victim.Position == types.POS_DEAD {
+		return false
+	}
+	return w.Random(types.LEVEL_IMMORTAL+4) < level && !w.SavesSpell(victim, types.SAVING_BREATH)
+}
+
+// destroyCarriedItem destroys one of the items the victim carries of the
+// given types, picked at random, telling the victim with msg
+func (w *World) destroyCarriedItem(victim *types.Character, msg string, itemTypes ...int) {
+	var candidates []*types.ObjectInstance
+	for _, obj := range victim.Inventory {
+		for _, itemType := range itemTypes {
+			if obj.Prototype.Type == itemType {
+				candidates = append(candidates, obj)
+				break
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return
+	}
+
+	obj := candidates[w.Random(len(candidates))]
+	w.Act(msg, false, victim, obj, nil, types.TO_CHAR)
+	w.ExtractObj(obj)
+}
+
+// CastFireBreath casts the fire breath spell. The flames may burn up one of
+// the victim's scrolls, wands, staves or notes.
+func (w *World) CastFireBreath(level int, ch *types.Character, arg string, spellType int, victim *types.Character, obj *types.ObjectInstance) error {
+	if victim == nil {
+		return fmt.Errorf("who should the spell be cast upon?")
+	}
+
+	w.Damage(ch, victim, w.breathDamage(ch, victim), types.SpellAttackType(types.SPELL_FIRE_BREATH))
+
+	if w.breathReachesItems(level, victim) {
+		w.destroyCarriedItem(victim, "$p burns.", types.ITEM_SCROLL, types.ITEM_WAND, types.ITEM_STAFF, types.ITEM_NOTE)
+	}
+
+	return nil
+}
+
+// CastGasBreath casts the gas breath spell, which fills the room and chokes
+// everyone in it but the breather
+func (w *World) CastGasBreath(level int, ch *types.Character, arg string, spellType int, victim *types.Character, obj *types.ObjectInstance) error {
+	room := ch.InRoom
+	if room == nil {
+		return fmt.Errorf("you are not in a room")
+	}
+
+	room.RLock()
+	victims := make([]*types.Character, 0, len(room.Characters))
+	for _, rch := range room.Characters {
+		if rch != ch {
+			victims = append(victims, rch)
+		}
+	}
+	room.RUnlock()
+
+	for _, rch := range victims {
+		w.Damage(ch, rch, w.breathDamage(ch, rch), types.SpellAttackType(types.SPELL_GAS_BREATH))
+	}
+
+	return nil
+}
+
+// CastFrostBreath casts the frost breath spell. The cold may shatter one of
+// the victim's potions, drink containers or food.
+func (w *World) CastFrostBreath(level int, ch *types.Character, arg string, spellType int, victim *types.Character, obj *types.ObjectInstance) error {
+	if victim == nil {
+		return fmt.Errorf("who should the spell be cast upon?")
+	}
+
+	w.Damage(ch, victim, w.breathDamage(ch, victim), types.SpellAttackType(types.SPELL_FROST_BREATH))
+
+	if w.breathReachesItems(level, victim) {
+		w.destroyCarriedItem(victim, "$p breaks.", types.ITEM_POTION, types.ITEM_DRINKCON, types.ITEM_FOOD)
+	}
+
+	return nil
+}
+
+// CastAcidBreath casts the acid breath spell. The acid may eat into a piece
+// of armor the victim wears, leaving it protecting them less.
+func (w *World) CastAcidBreath(level int, ch *types.Character, arg string, spellType int, victim *types.Character, obj *types.ObjectInstance) error {
+	if victim == nil {
+		return fmt.Errorf("who should the spell be cast upon?")
+	}
+
+	w.Damage(ch, victim, w.breathDamage(ch, victim), types.SpellAttackType(types.SPELL_ACID_BREATH))
+
+	if w.breathReachesItems(level, victim) {
+		var worn []*types.ObjectInstance
+		for _, eq := range victim.Equipment {
+			if eq != nil && eq.Prototype.Type == types.ITEM_ARMOR {
+				worn = append(worn, eq)
+			}
+		}
+		if len(worn) > 0 {
+			w.corrodeArmor(victim, worn[w.Random(len(worn))], 1+w.Random(7))
+		}
+	}
+
+	return nil
+}
+
+// corrodeArmor worsens the armor class a worn piece of armor gives by
+// amount. The damage is kept as an APPLY_AC affect on the instance so it
+// stays with the armor when it is removed and worn again.
+func (w *World) corrodeArmor(victim *types.Character, armor *types.ObjectInstance, amount int) {
+	slot := -1
+	for i := 0; i < types.MAX_OBJ_AFFECT; i++ {
+		if armor.Affects[i].Location == types.APPLY_AC {
+			slot = i
+			break
+		}
+		if slot < 0 && armor.Affects[i].Location == types.APPLY_NONE {
+			slot = i
+		}
+	}
+	if slot < 0 {
+		return
+	}
+
+	w.Act("$p is damaged.", false, victim, armor, nil, types.TO_CHAR)
+
+	armor.Affects[slot].Location = types.APPLY_AC
+	armor.Affects[slot].Modifier += amount
+	w.affectModify(victim, types.APPLY_AC, amount, 0, true)
+}
+
+// CastLightningBreath casts the lightning breath spell
+func (w *World) CastLightningBreath(level int, ch *types.Character, arg string, spellType int, victim *types.Character, obj *types.ObjectInstance) error {
+	if victim == nil {
+		return fmt.Errorf("who should the spell be cast upon?")
+	}
+
+	w.Damage(ch, victim, w.breathDamage(ch, victim), types.SpellAttackType(types.SPELL_LIGHTNING_BREATH))
+
+	return nil
+}
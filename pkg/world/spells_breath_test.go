@@ -0,0 +1,59 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+func TestBreathDestroysItems(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	room := &types.Room{VNUM: 1, Flags: types.ROOM_INDOORS}
+	dragon := &types.Character{Name: "dragon", IsNPC: true, Level: 30, HP: 400, Position: types.POS_FIGHTING, World: world}
+	// A victim who never saves and has plenty of hit points to lose
+	victim := &types.Character{Name: "Victim", Level: 20, HP: 10000, Position: types.POS_FIGHTING, World: world,
+		Equipment: make([]*types.ObjectInstance, types.NUM_WEARS)}
+	victim.SavingThrow[types.SAVING_BREATH] = 20
+	world.CharacterMove(dragon, room)
+	world.CharacterMove(victim, room)
+
+	scroll := &types.ObjectInstance{Prototype: &types.Object{Name: "scroll", ShortDesc: "a scroll", Type: types.ITEM_SCROLL}}
+	potion := &types.ObjectInstance{Prototype: &types.Object{Name: "potion", ShortDesc: "a potion", Type: types.ITEM_POTION}}
+	sword := &types.ObjectInstance{Prototype: &types.Object{Name: "sword", ShortDesc: "a sword", Type: types.ITEM_WEAPON}}
+	for _, obj := range []*types.ObjectInstance{scroll, potion, sword} {
+		world.ObjectToChar(obj, victim)
+	}
+	shield := &types.ObjectInstance{Prototype: &types.Object{Name: "shield", ShortDesc: "a shield", Type: types.ITEM_ARMOR}}
+	victim.Equipment[types.WEAR_SHIELD] = shield
+	shield.WornBy = victim
+
+	if err := world.CastSpell(types.SPELL_FIRE_BREATH, 30, dragon, "", types.SPELL_TYPE_SPELL, victim, nil); err != nil {
+		t.Fatalf("Expected fire breath to work, got %v", err)
+	}
+	if scroll.CarriedBy != nil {
+		t.Error("Expected the fire to burn the scroll")
+	}
+
+	if err := world.CastSpell(types.SPELL_FROST_BREATH, 30, dragon, "", types.SPELL_TYPE_SPELL, victim, nil); err != nil {
+		t.Fatalf("Expected frost breath to work, got %v", err)
+	}
+	if potion.CarriedBy != nil {
+		t.Error("Expected the frost to shatter the potion")
+	}
+
+	ac := victim.ArmorClass[0]
+	if err := world.CastSpell(types.SPELL_ACID_BREATH, 30, dragon, "", types.SPELL_TYPE_SPELL, victim, nil); err != nil {
+		t.Fatalf("Expected acid breath to work, got %v", err)
+	}
+	if shield.Affects[0].Location != types.APPLY_AC || shield.Affects[0].Modifier < 1 || victim.ArmorClass[0] != ac+shield.Affects[0].Modifier {
+		t.Error("Expected the acid to corrode the shield")
+	}
+
+	if sword.CarriedBy != victim {
+		t.Error("Expected the sword to come through unharmed")
+	}
+}
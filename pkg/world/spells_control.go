@@ -182,8 +182,7 @@ func (w *World) CastCharmPerson(level int, ch *types.Character, arg string, spel
 	w.AffectToChar(victim, affect)
 
 	// Set victim to follow caster
-	victim.Following = ch
-	ch.Followers = append(ch.Followers, victim)
+	w.AddFollower(victim, ch)
 
 	// Stop victim from fighting
 	if victim.Fighting != nil {
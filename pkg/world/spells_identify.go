@@ -0,0 +1,89 @@
+package world
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// CastIdentify casts the identify spell, telling the caster what an object
+// is made of or, for a player, their vital statistics
+func (w *World) CastIdentify(level int, ch *types.Character, arg string, spellType int, victim *types.Character, obj *types.ObjectInstance) error {
+	if obj != nil {
+		ch.SendMessage(identifyObject(obj))
+		return nil
+	}
+
+	if victim == nil {
+		return fmt.Errorf("what should the spell be cast upon?")
+	}
+
+	if victim.IsNPC {
+		ch.SendMessage("You learn nothing new.\r\n")
+		return nil
+	}
+
+	ch.SendMessage(fmt.Sprintf("%s is a level %d %s.\r\n", victim.Name, victim.Level, types.GetClassName(victim.Class)))
+	ch.SendMessage(fmt.Sprintf("Armor Class %d\r\n", victim.ArmorClass[0]))
+	ch.SendMessage(fmt.Sprintf("Str %d, Int %d, Wis %d, Dex %d, Con %d\r\n",
+		victim.Abilities[0], victim.Abilities[1], victim.Abilities[2], victim.Abilities[3], victim.Abilities[4]))
+
+	return nil
+}
+
+// identifyObject describes an object's type, flags, values, weight, cost
+// and affects
+func identifyObject(obj *types.ObjectInstance) string {
+	proto := obj.Prototype
+
+	var sb strings.Builder
+	sb.WriteString("You feel informed:\r\n")
+	sb.WriteString(fmt.Sprintf("Object '%s', Item type: %s\r\n", proto.Name, types.GetItemTypeName(proto.Type)))
+	sb.WriteString(fmt.Sprintf("Item is: %s\r\n", types.BitNames(proto.ExtraFlags, types.ExtraFlagNames)))
+	sb.WriteString(fmt.Sprintf("Weight: %d, Value: %d\r\n", proto.Weight, proto.Cost))
+
+	switch proto.Type {
+	case types.ITEM_SCROLL, types.ITEM_POTION:
+		sb.WriteString(fmt.Sprintf("Level %d spells of:\r\n", proto.Value[0]))
+		for i := 1; i <= 3; i++ {
+			if proto.Value[i] > 0 {
+				sb.WriteString(types.GetSpellName(proto.Value[i]) + "\r\n")
+			}
+		}
+	case types.ITEM_WAND, types.ITEM_STAFF:
+		sb.WriteString(fmt.Sprintf("Has %d charges, with %d charges left.\r\n", proto.Value[1], proto.Value[2]))
+		sb.WriteString(fmt.Sprintf("Level %d spell of:\r\n", proto.Value[0]))
+		if proto.Value[3] > 0 {
+			sb.WriteString(types.GetSpellName(proto.Value[3]) + "\r\n")
+		}
+	case types.ITEM_WEAPON:
+		sb.WriteString(fmt.Sprintf("Damage Dice is '%dD%d'\r\n", proto.Value[1], proto.Value[2]))
+	case types.ITEM_ARMOR:
+		sb.WriteString(fmt.Sprintf("AC-apply is %d\r\n", proto.Value[0]))
+	case types.ITEM_LIGHT:
+		if hours := obj.LightHours(); hours < 0 {
+			sb.WriteString("Burns forever\r\n")
+		} else {
+			sb.WriteString(fmt.Sprintf("Hours of light left: %d\r\n", hours))
+		}
+	}
+
+	// Enchantments live on the instance, everything else on the prototype
+	var affects []string
+	for i := 0; i < types.MAX_OBJ_AFFECT; i++ {
+		for _, af := range []struct{ Location, Modifier int }{proto.Affects[i], obj.Affects[i]} {
+			if af.Location != types.APPLY_NONE && af.Location < len(types.ApplyNames) && af.Modifier != 0 {
+				affects = append(affects, fmt.Sprintf("    Affects : %s By %d\r\n", types.ApplyNames[af.Location], af.Modifier))
+			}
+		}
+	}
+	if len(affects) > 0 {
+		sb.WriteString("Can affect you as :\r\n")
+		for _, line := range affects {
+			sb.WriteString(line)
+		}
+	}
+
+	return sb.String()
+}
@@ -204,7 +204,7 @@ func (w *World) CastEnergyDrain(level int, ch *types.Character, arg string, spel
 		// Drain 5% of experience
 		expDrain := victim.Experience / 20
 		if expDrain > 0 {
-			victim.Experience -= expDrain
+			w.GainExp(victim, -expDrain)
 			victim.SendMessage("You feel less experienced!\r\n")
 			ch.SendMessage("You feel more powerful as you drain their life force!\r\n")
 		}
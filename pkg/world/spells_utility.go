@@ -633,10 +633,8 @@ func (w *World) CreateObject(vnum int) *types.ObjectInstance {
 	}
 
 	// Create a new instance
-	obj := &types.ObjectInstance{
-		Prototype: prototype,
-		Timer:     -1, // Permanent
-	}
+	obj := newObjectInstance(prototype)
+	obj.Timer = -1 // Permanent
 
 	return obj
 }
@@ -679,3 +677,128 @@ func (w *World) CastSenseLife(level int, ch *types.Character, arg string, spellT
 
 	return nil
 }
+
+// CastTeleport casts the teleport spell, sending the victim to a random room
+// that isn't private or a death trap
+func (w *World) CastTeleport(level int, ch *types.Character, arg string, spellType int, victim *types.Character, obj *types.ObjectInstance) error {
+	if victim == nil {
+		victim = ch
+	}
+
+	var rooms []*types.Room
+	for _, room := range w.GetRooms() {
+		if room != victim.InRoom && room.Flags&(types.ROOM_PRIVATE|types.ROOM_DEATH) == 0 {
+			rooms = append(rooms, room)
+		}
+	}
+	if len(rooms) == 0 {
+		return fmt.Errorf("you failed")
+	}
+	toRoom := rooms[w.Random(len(rooms))]
+
+	w.Act("$n slowly fades out of existence.", false, victim, nil, nil, types.TO_ROOM)
+	w.CharFromRoom(victim)
+	w.CharToRoom(victim, toRoom)
+	w.Act("$n slowly fades into existence.", false, victim, nil, nil, types.TO_ROOM)
+	victim.SendMessage("You look around...\r\n")
+
+	return nil
+}
+
+// CastVentriloquate casts the ventriloquate spell. Whatever follows the
+// target's name seems to come from the target, though those who save see
+// through the trick.
+func (w *World) CastVentriloquate(level int, ch *types.Character, arg string, spellType int, victim *types.Character, obj *types.ObjectInstance) error {
+	if victim == nil && obj == nil {
+		return fmt.Errorf("who should the spell be cast upon?")
+	}
+
+	// Drop the target's name to leave the words to be spoken
+	words := ""
+	if fields := strings.SplitN(strings.TrimSpace(arg), " ", 2); len(fields) == 2 {
+		words = strings.TrimSpace(fields[1])
+	}
+	if words == "" {
+		return fmt.Errorf("what should be said?")
+	}
+
+	room := ch.InRoom
+	room.RLock()
+	listeners := make([]*types.Character, len(room.Characters))
+	copy(listeners, room.Characters)
+	room.RUnlock()
+
+	for _, rch := range listeners {
+		if rch == ch || rch == victim || !awake(rch) {
+			continue
+		}
+
+		speaker := ""
+		if victim != nil {
+			speaker = w.pers(victim, rch)
+		} else {
+			speaker = "The " + strings.Fields(obj.Prototype.Name)[0]
+		}
+
+		said := fmt.Sprintf("%s says '%s'", speaker, words)
+		if w.SavesSpell(rch, types.SAVING_SPELL) {
+			said = fmt.Sprintf("Someone says, \"%s\"", said)
+		}
+		rch.SendMessage(said + "\r\n")
+	}
+
+	ch.SendMessage("Ok.\r\n")
+	return nil
+}
+
+// cloneCostPerLevel is how many coins an object may be worth for each level
+// of the clone spell copying it
+const cloneCostPerLevel = 100
+
+// CastClone casts the clone spell. An object the caster carries is copied
+// into the caster's inventory, unless it is magical or worth more than the
+// spell's level allows. A mobile no more than half the spell's level is
+// copied into the room.
+func (w *World) CastClone(level int, ch *types.Character, arg string, spellType int, victim *types.Character, obj *types.ObjectInstance) error {
+	if obj != nil {
+		if obj.Prototype.ExtraFlags&types.ITEM_MAGIC != 0 {
+			w.Act("$p resists your magic.", false, ch, obj, nil, types.TO_CHAR)
+			return nil
+		}
+
+		if obj.Prototype.Cost > level*cloneCostPerLevel {
+			w.Act("$p is too powerful for you to clone.", false, ch, obj, nil, types.TO_CHAR)
+			return nil
+		}
+
+		clone := w.CreateObjectFromPrototype(obj.Prototype.VNUM)
+		if clone == nil {
+			return fmt.Errorf("you failed")
+		}
+		clone.Value = obj.Value
+		w.ObjectToChar(clone, ch)
+		w.Act("$n creates a duplicate of $p.", true, ch, obj, nil, types.TO_ROOM)
+		w.Act("You create a duplicate of $p.", false, ch, obj, nil, types.TO_CHAR)
+		return nil
+	}
+
+	if victim == nil {
+		return fmt.Errorf("what should the spell be cast upon?")
+	}
+	if !victim.IsNPC || victim.Prototype == nil {
+		return fmt.Errorf("you can't clone players")
+	}
+	if victim.Level > level/2 {
+		w.Act("$N is too powerful for you to clone.", false, ch, nil, victim, types.TO_CHAR)
+		return nil
+	}
+
+	clone := w.CreateMobFromPrototype(victim.Prototype.VNUM, ch.InRoom)
+	if clone == nil {
+		return fmt.Errorf("you failed")
+	}
+	w.Act("$N has been cloned!", false, ch, nil, victim, types.TO_NOTVICT)
+	w.Act("You clone $N.", false, ch, nil, victim, types.TO_CHAR)
+
+	return nil
+}
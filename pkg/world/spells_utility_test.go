@@ -0,0 +1,168 @@
+package world
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+func TestTeleportAvoidsPrivateAndDeathRooms(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	start := &types.Room{VNUM: 1}
+	safe := &types.Room{VNUM: 2}
+	world.rooms[1] = start
+	world.rooms[2] = safe
+	world.rooms[3] = &types.Room{VNUM: 3, Flags: types.ROOM_PRIVATE}
+	world.rooms[4] = &types.Room{VNUM: 4, Flags: types.ROOM_DEATH}
+
+	mage := &types.Character{Name: "Mage", Level: 10, Class: types.CLASS_MAGIC_USER, World: world}
+	for i := 0; i < 10; i++ {
+		world.CharacterMove(mage, start)
+		if err := world.CastSpell(types.SPELL_TELEPORT, 10, mage, "", types.SPELL_TYPE_SPELL, mage, nil); err != nil {
+			t.Fatalf("Expected teleport to work, got %v", err)
+		}
+		if mage.InRoom != safe {
+			t.Fatalf("Expected to land in the only safe room, got room %d", mage.InRoom.VNUM)
+		}
+	}
+}
+
+func TestIdentifyObject(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	var received []string
+	world.SetMessageHandler(func(ch *types.Character, message string) {
+		received = append(received, message)
+	})
+
+	sword := &types.ObjectInstance{Prototype: &types.Object{
+		Name: "sword long", Type: types.ITEM_WEAPON, ExtraFlags: types.ITEM_GLOW | types.ITEM_MAGIC,
+		Value: [4]int{0, 2, 6, 3}, Weight: 12, Cost: 400,
+	}}
+	sword.Prototype.Affects[0].Location = types.APPLY_STR
+	sword.Prototype.Affects[0].Modifier = 1
+	sword.Affects[0].Location = types.APPLY_DAMROLL
+	sword.Affects[0].Modifier = 2
+
+	reader := &types.Character{Name: "Reader", World: world}
+	if err := world.CastSpell(types.SPELL_IDENTIFY, 12, reader, "", types.SPELL_TYPE_SCROLL, nil, sword); err != nil {
+		t.Fatalf("Expected identify to work, got %v", err)
+	}
+
+	output := strings.Join(received, "")
+	for _, want := range []string{
+		"Object 'sword long', Item type: WEAPON",
+		"Item is: GLOW MAGIC",
+		"Weight: 12, Value: 400",
+		"Damage Dice is '2D6'",
+		"Affects : STR By 1",
+		"Affects : DAMROLL By 2",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected identify to report %q, got %q", want, output)
+		}
+	}
+}
+
+func TestVentriloquate(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	received := make(map[*types.Character][]string)
+	world.SetMessageHandler(func(ch *types.Character, message string) {
+		received[ch] = append(received[ch], message)
+	})
+
+	room := &types.Room{VNUM: 1, Flags: types.ROOM_INDOORS}
+	mage := &types.Character{Name: "Mage", Level: 5, Position: types.POS_STANDING, World: world}
+	dummy := &types.Character{Name: "Dummy", Position: types.POS_STANDING, World: world}
+	listener := &types.Character{Name: "Listener", Position: types.POS_STANDING, World: world}
+	for _, ch := range []*types.Character{mage, dummy, listener} {
+		world.CharacterMove(ch, room)
+	}
+
+	if err := world.CastSpell(types.SPELL_VENTRILOQUATE, 5, mage, "dummy hello there", types.SPELL_TYPE_SPELL, dummy, nil); err != nil {
+		t.Fatalf("Expected ventriloquate to work, got %v", err)
+	}
+
+	heard := received[listener]
+	if len(heard) != 1 || !strings.Contains(heard[0], "Dummy says 'hello there'") {
+		t.Errorf("Expected the listener to hear the dummy speak, got %q", heard)
+	}
+	if len(received[dummy]) != 0 {
+		t.Errorf("Expected the dummy to hear nothing, got %q", received[dummy])
+	}
+}
+
+func TestClone(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	room := &types.Room{VNUM: 1}
+	world.rooms[1] = room
+	world.mobiles[100] = &types.Mobile{VNUM: 100, Name: "rabbit", ShortDesc: "a rabbit", Level: 3}
+	world.mobiles[101] = &types.Mobile{VNUM: 101, Name: "dragon", ShortDesc: "a dragon", Level: 20}
+
+	mage := &types.Character{Name: "Mage", Level: 15, Class: types.CLASS_MAGIC_USER, World: world,
+		Equipment: make([]*types.ObjectInstance, types.NUM_WEARS)}
+	world.CharacterMove(mage, room)
+
+	world.objects[200] = &types.Object{VNUM: 200, Name: "bread", Type: types.ITEM_FOOD, Value: [4]int{5, 0, 0, 0}, Cost: 10}
+	world.objects[201] = &types.Object{VNUM: 201, Name: "crown", Type: types.ITEM_TREASURE, Cost: 5000}
+	bread := world.CreateObjectFromPrototype(200)
+	bread.Value[0] = 3
+	world.ObjectToChar(bread, mage)
+	if err := world.CastSpell(types.SPELL_CLONE, 15, mage, "bread", types.SPELL_TYPE_SPELL, nil, bread); err != nil {
+		t.Fatalf("Expected clone to work, got %v", err)
+	}
+	if len(mage.Inventory) != 2 || mage.Inventory[1].Prototype != bread.Prototype || mage.Inventory[1] == bread {
+		t.Fatal("Expected a second loaf of bread")
+	}
+	if mage.Inventory[1].Value != bread.Value {
+		t.Errorf("Expected the copy to share the loaf's values, got %v", mage.Inventory[1].Value)
+	}
+
+	// The crown is worth more than a level 15 clone can copy
+	crown := world.CreateObjectFromPrototype(201)
+	world.ObjectToChar(crown, mage)
+	if err := world.CastSpell(types.SPELL_CLONE, 15, mage, "crown", types.SPELL_TYPE_SPELL, nil, crown); err != nil {
+		t.Fatalf("Expected clone to fail quietly, got %v", err)
+	}
+	if len(mage.Inventory) != 3 {
+		t.Error("Expected the crown not to be cloned")
+	}
+
+	rabbit := world.CreateMobFromPrototype(100, room)
+	if err := world.CastSpell(types.SPELL_CLONE, 15, mage, "rabbit", types.SPELL_TYPE_SPELL, rabbit, nil); err != nil {
+		t.Fatalf("Expected clone to work, got %v", err)
+	}
+	dragon := world.CreateMobFromPrototype(101, room)
+	if err := world.CastSpell(types.SPELL_CLONE, 15, mage, "dragon", types.SPELL_TYPE_SPELL, dragon, nil); err != nil {
+		t.Fatalf("Expected clone to fail quietly, got %v", err)
+	}
+
+	rabbits, dragons := 0, 0
+	for _, ch := range room.Characters {
+		switch ch.Name {
+		case "rabbit":
+			rabbits++
+		case "dragon":
+			dragons++
+		}
+	}
+	if rabbits != 2 || dragons != 1 {
+		t.Errorf("Expected the rabbit but not the dragon to be cloned, got %d rabbits and %d dragons", rabbits, dragons)
+	}
+}
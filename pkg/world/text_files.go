@@ -0,0 +1,50 @@
+package world
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// TextFileNames are the static text files in the lib directory that can be
+// shown to players
+var TextFileNames = []string{"news", "credits", "motd", "info", "wizlist", "policy"}
+
+// textFiles caches the contents of the static text files
+type textFiles struct {
+	files map[string]string
+	mutex sync.RWMutex
+}
+
+// LoadTextFiles reads the static text files from the lib directory,
+// replacing any read before. Missing files are left empty. It returns the
+// number of files read.
+func (w *World) LoadTextFiles() (int, error) {
+	files := make(map[string]string, len(TextFileNames))
+	for _, name := range TextFileNames {
+		data, err := os.ReadFile(filepath.Join(w.DataPath(), name))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		files[name] = strings.ReplaceAll(strings.TrimRight(string(data), "\r\n"), "\r\n", "\n")
+	}
+
+	w.text.mutex.Lock()
+	w.text.files = files
+	w.text.mutex.Unlock()
+
+	return len(files), nil
+}
+
+// TextFile returns the contents of a static text file with "\r\n" line
+// endings, or "" if it isn't loaded
+func (w *World) TextFile(name string) string {
+	w.text.mutex.RLock()
+	defer w.text.mutex.RUnlock()
+	return strings.ReplaceAll(w.text.files[name], "\n", "\r\n")
+}
@@ -0,0 +1,31 @@
+package world
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/config"
+)
+
+func TestLoadTextFiles(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Game.DataPath = "../../lib"
+
+	world, err := NewWorld(cfg, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	// lib has everything but a policy
+	if count, err := world.LoadTextFiles(); err != nil || count != len(TextFileNames)-1 {
+		t.Fatalf("Expected %d text files, got %d (%v)", len(TextFileNames)-1, count, err)
+	}
+
+	credits := world.TextFile("credits")
+	if credits == "" || strings.Contains(strings.ReplaceAll(credits, "\r\n", ""), "\n") {
+		t.Errorf("Expected credits with \\r\\n line endings, got %q", credits)
+	}
+	if world.TextFile("policy") != "" {
+		t.Error("Expected no policy")
+	}
+}
@@ -88,8 +88,14 @@ func (w *World) InitializeTime() {
 		w.time.Hours, 0, w.time.Day+1, w.time.Month+1, w.time.Year, w.time.Sunlight, w.time.Weather)
 }
 
-// PulseTime updates the game time
+// PulseTime updates the game time and burns down the lights players carry
 func (w *World) PulseTime() {
+	w.advanceTime()
+	w.burnLights()
+}
+
+// advanceTime moves the game clock on an hour
+func (w *World) advanceTime() {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
@@ -199,8 +205,8 @@ func (w *World) SendToOutdoor(message string) {
 	for _, ch := range w.characters {
 		// Check if character is in a room
 		if ch.InRoom != nil {
-			// Check if the room is outdoors (not INDOORS flag)
-			if ch.InRoom.Flags&types.ROOM_INDOORS == 0 {
+			// Check if the room is outdoors (not INDOORS flag) and the character is awake
+			if ch.InRoom.Flags&types.ROOM_INDOORS == 0 && awake(ch) {
 				// Send message to character
 				if w.messageHandler != nil {
 					w.messageHandler(ch, message)
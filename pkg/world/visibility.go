@@ -0,0 +1,90 @@
+package world
+
+import (
+	"strings"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+// CanSee returns true if viewer can make out target. Immortals with
+// holylight see everyone. Anyone else needs their eyes and some light,
+// detect invisible to find the invisible and sense life to find the hidden.
+func (w *World) CanSee(viewer, target *types.Character) bool {
+	if viewer == nil || target == nil || viewer == target {
+		return true
+	}
+
+	if hasHolylight(viewer) {
+		return true
+	}
+
+	if !w.canSeeAround(viewer) {
+		return false
+	}
+
+	if target.AffectedBy&types.AFF_INVISIBLE != 0 && viewer.AffectedBy&types.AFF_DETECT_INVISIBLE == 0 {
+		return false
+	}
+
+	if target.AffectedBy&types.AFF_HIDE != 0 && viewer.AffectedBy&types.AFF_SENSE_LIFE == 0 {
+		return false
+	}
+
+	return true
+}
+
+// CanSeeObj returns true if viewer can make out obj, following the same
+// rules as CanSee
+func (w *World) CanSeeObj(viewer *types.Character, obj *types.ObjectInstance) bool {
+	if viewer == nil || obj == nil {
+		return true
+	}
+
+	if hasHolylight(viewer) {
+		return true
+	}
+
+	if !w.canSeeAround(viewer) {
+		return false
+	}
+
+	if obj.Prototype.ExtraFlags&types.ITEM_INVISIBLE != 0 && viewer.AffectedBy&types.AFF_DETECT_INVISIBLE == 0 {
+		return false
+	}
+
+	return true
+}
+
+// canSeeAround returns true if the viewer isn't blind and isn't standing in
+// the dark without infravision
+func (w *World) canSeeAround(viewer *types.Character) bool {
+	if viewer.AffectedBy&types.AFF_BLIND != 0 {
+		return false
+	}
+	return viewer.AffectedBy&types.AFF_INFRAVISION != 0 || !w.IsDark(viewer.InRoom)
+}
+
+// hasHolylight returns true if ch is an immortal who sees everything
+func hasHolylight(ch *types.Character) bool {
+	return !ch.IsNPC && ch.Level >= types.LEVEL_IMMORTAL && ch.Flags&types.PLR_HOLYLIGHT != 0
+}
+
+// GetVisibleCharacterInRoom finds a character in the viewer's room by name,
+// passing over anyone the viewer can't see
+func (w *World) GetVisibleCharacterInRoom(viewer *types.Character, name string) *types.Character {
+	room := viewer.InRoom
+	if room == nil || name == "" {
+		return nil
+	}
+
+	room.RLock()
+	defer room.RUnlock()
+
+	name = strings.ToLower(name)
+	for _, ch := range room.Characters {
+		if strings.Contains(strings.ToLower(ch.Name), name) && w.CanSee(viewer, ch) {
+			return ch
+		}
+	}
+	return nil
+}
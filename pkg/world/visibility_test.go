@@ -0,0 +1,123 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/wltechblog/DikuGo/pkg/types"
+)
+
+func TestCanSeeHiddenCharacters(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	viewer := &types.Character{Name: "Alice"}
+	thief := &types.Character{Name: "Bob", AffectedBy: types.AFF_HIDE}
+
+	if world.CanSee(viewer, thief) {
+		t.Error("Expected a hidden character to go unseen")
+	}
+	if !world.CanSee(thief, thief) {
+		t.Error("Expected a hidden character to see itself")
+	}
+
+	viewer.AffectedBy |= types.AFF_SENSE_LIFE
+	if !world.CanSee(viewer, thief) {
+		t.Error("Expected sense life to reveal a hidden character")
+	}
+}
+
+func TestCanSeeInvisibleBlindAndDark(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	room := &types.Room{VNUM: 1}
+	viewer := &types.Character{Name: "Alice", InRoom: room, Equipment: make([]*types.ObjectInstance, types.NUM_WEARS)}
+	ghost := &types.Character{Name: "Ghost", InRoom: room, AffectedBy: types.AFF_INVISIBLE}
+	ring := &types.ObjectInstance{Prototype: &types.Object{Name: "ring", ExtraFlags: types.ITEM_INVISIBLE}}
+	room.Characters = []*types.Character{viewer, ghost}
+
+	if world.CanSee(viewer, ghost) || world.CanSeeObj(viewer, ring) {
+		t.Error("Expected invisible things to go unseen")
+	}
+	viewer.AffectedBy |= types.AFF_DETECT_INVISIBLE
+	if !world.CanSee(viewer, ghost) || !world.CanSeeObj(viewer, ring) {
+		t.Error("Expected detect invisible to reveal invisible things")
+	}
+
+	viewer.AffectedBy |= types.AFF_BLIND
+	if world.CanSee(viewer, ghost) || world.CanSeeObj(viewer, ring) {
+		t.Error("Expected the blind to see nothing")
+	}
+	viewer.AffectedBy &^= types.AFF_BLIND
+
+	room.Flags |= types.ROOM_DARK
+	if !world.IsDark(room) || world.CanSee(viewer, ghost) {
+		t.Error("Expected nothing to be seen in a dark room")
+	}
+
+	torch := &types.ObjectInstance{Prototype: &types.Object{Name: "torch", Type: types.ITEM_LIGHT, Value: [4]int{0, 0, 10, 0}}}
+	viewer.Equipment[types.WEAR_LIGHT] = torch
+	world.UpdateRoomLight(room)
+	if world.IsDark(room) || !world.CanSee(viewer, ghost) {
+		t.Error("Expected a burning light to light up a dark room")
+	}
+	torch.Value[2] = 10
+	world.UpdateRoomLight(room)
+	if !world.IsDark(room) {
+		t.Error("Expected a burnt out light not to light up the room")
+	}
+
+	viewer.Level = types.LEVEL_IMMORTAL
+	viewer.Flags |= types.PLR_HOLYLIGHT
+	viewer.AffectedBy = types.AFF_BLIND
+	ghost.AffectedBy |= types.AFF_HIDE
+	if !world.CanSee(viewer, ghost) || !world.CanSeeObj(viewer, ring) {
+		t.Error("Expected holylight to show everything")
+	}
+}
+
+func TestCharacterMoveAnnouncesUnlessSneaking(t *testing.T) {
+	world, err := NewWorld(nil, NewMockStorage())
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	received := make(map[*types.Character][]string)
+	world.SetMessageHandler(func(ch *types.Character, message string) {
+		received[ch] = append(received[ch], message)
+	})
+
+	start := &types.Room{VNUM: 1}
+	dest := &types.Room{VNUM: 2}
+	start.Exits[types.DIR_NORTH] = &types.Exit{DestVnum: 2}
+	dest.Exits[types.DIR_SOUTH] = &types.Exit{DestVnum: 1}
+
+	watcher := &types.Character{Name: "Watcher", Position: types.POS_STANDING, World: world}
+	greeter := &types.Character{Name: "Greeter", Position: types.POS_STANDING, World: world}
+	thief := &types.Character{Name: "Thief", Position: types.POS_STANDING, World: world, AffectedBy: types.AFF_HIDE}
+	world.CharacterMove(watcher, start)
+	world.CharacterMove(greeter, dest)
+	world.CharacterMove(thief, start)
+
+	world.CharacterMove(thief, dest)
+	if len(received[watcher]) != 1 || received[watcher][0] != "Thief leaves north.\r\n" {
+		t.Errorf("Expected the watcher to see the thief leave, got %q", received[watcher])
+	}
+	if len(received[greeter]) != 1 || received[greeter][0] != "Thief has arrived.\r\n" {
+		t.Errorf("Expected the greeter to see the thief arrive, got %q", received[greeter])
+	}
+	if thief.AffectedBy&types.AFF_HIDE != 0 {
+		t.Error("Expected moving to bring the thief out of hiding")
+	}
+
+	received = make(map[*types.Character][]string)
+	thief.AffectedBy |= types.AFF_SNEAK
+	world.CharacterMove(thief, start)
+	if len(received[watcher]) != 0 || len(received[greeter]) != 0 {
+		t.Errorf("Expected a sneaking thief to move unnoticed, got %q and %q", received[watcher], received[greeter])
+	}
+}
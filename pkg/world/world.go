@@ -25,6 +25,10 @@ type Storage interface {
 	LoadCharacter(name string) (*types.Character, error)
 	DeleteCharacter(name string) error
 	CharacterExists(name string) bool
+	LoadPlayerObjects(name string) ([]*types.ObjectInstance, error)
+	SavePlayerObjects(name string, objects []*types.ObjectInstance) error
+	LoadPlayerMail(name string) ([]*types.MailMessage, error)
+	SavePlayerMail(name string, mail []*types.MailMessage) error
 }
 
 // TimeWeather represents the time and weather in the game world
@@ -74,6 +78,18 @@ type World struct {
 
 	// Message handler
 	messageHandler func(*types.Character, string) // Function to handle messages to characters
+
+	// Static text files such as news and credits
+	text textFiles
+
+	// Bulletin board messages
+	boards boards
+
+	// Combat messages from the messages file
+	combatMessages combatMessages
+
+	// Serializes reading and writing the post office's mail
+	mailMutex sync.Mutex
 }
 
 // NewWorld creates a new world instance
@@ -100,6 +116,27 @@ func NewWorld(cfg *config.Config, store Storage) (*World, error) {
 		return nil, err
 	}
 
+	// Load the static text files
+	if count, err := w.LoadTextFiles(); err != nil {
+		log.Printf("Warning: failed to load text files: %v", err)
+	} else {
+		log.Printf("Loaded %d text files", count)
+	}
+
+	// Load the combat messages
+	if count, err := w.LoadMessages(); err != nil {
+		log.Printf("Warning: failed to load combat messages: %v", err)
+	} else {
+		log.Printf("Loaded %d combat messages", count)
+	}
+
+	// Load the bulletin board messages
+	if count, err := w.LoadBoards(); err != nil {
+		log.Printf("Warning: failed to load board messages: %v", err)
+	} else {
+		log.Printf("Loaded %d board messages", count)
+	}
+
 	// Perform initial zone reset to spawn mobs
 	log.Println("Performing initial zone reset...")
 	// Force all zones to reset by setting their age to their lifespan
@@ -390,6 +427,8 @@ func (w *World) AddCharacter(character *types.Character) {
 
 	// --- Step 4: Release world lock ---
 	w.mutex.Unlock()
+
+	w.UpdateRoomLight(character.InRoom)
 }
 
 // resetPlayerCharacter resets a player character's state when entering the game
@@ -422,6 +461,9 @@ func (w *World) resetPlayerCharacter(ch *types.Character) {
 
 // RemoveCharacter removes a character from the world
 func (w *World) RemoveCharacter(character *types.Character) {
+	// Leaving the game breaks up any following or group
+	w.DieFollower(character)
+
 	w.mutex.Lock()
 
 	// Remove character from the world map
@@ -445,6 +487,8 @@ func (w *World) RemoveCharacter(character *types.Character) {
 	}
 
 	w.mutex.Unlock()
+
+	w.UpdateRoomLight(sourceRoom)
 }
 
 // DeleteCharacter deletes a character from storage
@@ -565,18 +609,26 @@ func (w *World) GetCharacterInRoom(room *types.Room, name string) *types.Charact
 	return nil
 }
 
-// Damage applies damage to a character
-func (w *World) Damage(ch *types.Character, victim *types.Character, damage int, spellID int) {
+// Damage applies damage to a character and shows everyone the messages
+// for the attack type, one of the types.*AttackType numbers. Immortals
+// take no damage.
+func (w *World) Damage(ch *types.Character, victim *types.Character, damage int, attackType int) {
 	if ch == nil || victim == nil {
 		return
 	}
 
+	if !victim.IsNPC && victim.Level >= types.LEVEL_IMMORTAL {
+		damage = 0
+	}
+
 	// Apply damage
 	victim.HP -= damage
 	if victim.HP < 0 {
 		victim.HP = 0
 	}
 
+	w.DamageMessages(ch, victim, damage, attackType)
+
 	// Check if victim is dead
 	if victim.HP <= 0 {
 		// Handle death
@@ -597,7 +649,6 @@ func (w *World) CharFromRoom(ch *types.Character) {
 
 	// Lock the room before modifying
 	room.Lock()
-	defer room.Unlock()
 
 	// Remove character from room
 	for i, rch := range room.Characters {
@@ -609,6 +660,10 @@ func (w *World) CharFromRoom(ch *types.Character) {
 	}
 
 	ch.InRoom = nil
+
+	room.Unlock()
+
+	w.UpdateRoomLight(room)
 }
 
 // CharToRoom adds a character to a room (DEPRECATED - use CharacterMove instead)
@@ -620,12 +675,15 @@ func (w *World) CharToRoom(ch *types.Character, room *types.Room) {
 
 	// Lock the room before modifying
 	room.Lock()
-	defer room.Unlock()
 
 	// Add character to room
 	room.Characters = append(room.Characters, ch)
 	ch.InRoom = room
 	ch.RoomVNUM = room.VNUM
+
+	room.Unlock()
+
+	w.UpdateRoomLight(room)
 }
 
 // ObjectToChar adds an object to a character's inventory
@@ -657,6 +715,10 @@ func (w *World) UnequipChar(ch *types.Character, position int) {
 
 	// Add object to inventory
 	w.ObjectToChar(obj, ch)
+
+	if position == types.WEAR_LIGHT {
+		w.UpdateRoomLight(ch.InRoom)
+	}
 }
 
 // ExtractObj removes an object from the game
@@ -684,6 +746,9 @@ func (w *World) ExtractObj(obj *types.ObjectInstance) {
 				break
 			}
 		}
+		if obj.WornOn == types.WEAR_LIGHT {
+			w.UpdateRoomLight(obj.WornBy.InRoom)
+		}
 		obj.WornBy = nil
 	}
 
@@ -706,6 +771,7 @@ func (w *World) ExtractObj(obj *types.ObjectInstance) {
 				break
 			}
 		}
+		w.UpdateRoomLight(obj.InRoom)
 		obj.InRoom = nil
 	}
 
@@ -741,16 +807,62 @@ func (w *World) SetMessageHandler(handler func(*types.Character, string)) {
 	w.messageHandler = handler
 }
 
-// CharacterMove moves a character from one room to another
+// DataPath returns the directory holding the game's lib files
+func (w *World) DataPath() string {
+	if w.config == nil || w.config.Game.DataPath == "" {
+		return "lib"
+	}
+	return w.config.Game.DataPath
+}
+
+// dirNames are the names of the directions, indexed by DIR_*
+var dirNames = [...]string{"north", "east", "south", "west", "up", "down"}
+
+// CharacterMove moves a character from one room to another. Walking through an
+// exit is announced in both rooms unless the character is sneaking, and
+// any move brings a hiding character out into the open.
 func (w *World) CharacterMove(character *types.Character, destRoom *types.Room) {
 	sourceRoom := character.InRoom
-
-	// --- Early exit if no actual move needed ---
 	if sourceRoom == destRoom {
 		log.Printf("CharacterMove: Source and destination room are the same (%v). No move needed.", sourceRoom)
 		return
 	}
 
+	character.AffectedBy &^= types.AFF_HIDE
+
+	dir := exitDirection(sourceRoom, destRoom)
+	announce := dir >= 0 && character.AffectedBy&types.AFF_SNEAK == 0
+	if announce {
+		w.Act(fmt.Sprintf("$n leaves %s.", dirNames[dir]), true, character, nil, nil, types.TO_ROOM)
+	}
+
+	w.moveCharacter(character, destRoom)
+	w.UpdateRoomLight(sourceRoom)
+	w.UpdateRoomLight(destRoom)
+
+	if announce {
+		w.Act("$n has arrived.", true, character, nil, nil, types.TO_ROOM)
+	}
+}
+
+// exitDirection returns the direction of the exit leading from one room to
+// the other, or -1 if there is none
+func exitDirection(from, to *types.Room) int {
+	if from == nil || to == nil {
+		return -1
+	}
+	for dir, exit := range from.Exits {
+		if exit != nil && exit.DestVnum == to.VNUM {
+			return dir
+		}
+	}
+	return -1
+}
+
+// moveCharacter moves a single character from one room to another
+func (w *World) moveCharacter(character *types.Character, destRoom *types.Room) {
+	sourceRoom := character.InRoom
+
 	// --- Define lock order based on VNUM to prevent AB-BA deadlocks between rooms ---
 	var lockRoom1, lockRoom2 *types.Room
 	// Handle nil rooms gracefully in lock ordering
@@ -28,13 +28,20 @@ func (w *World) resetZone(zone *types.Zone) {
 	// Keep track of the last loaded mob (regardless of VNUM)
 	var lastLoadedMob *types.Character
 
+	// Keep track of the last object loaded of each VNUM, so P commands put
+	// their objects into the container the zone has just loaded
+	lastLoadedObj := make(map[int]*types.ObjectInstance)
+
+	// Whether the previous command did anything, for commands with IfFlag set
+	lastCmd := true
+
 	log.Printf("Starting zone reset for zone %d: %s", zone.VNUM, zone.Name)
 
 	// Process all zone commands
 	for _, cmd := range zone.Commands {
-		// Skip if the command is not enabled
-		if cmd.IfFlag != 0 {
-			// TODO: Implement if-flag logic
+		// Commands with IfFlag set only run if the previous command did
+		if cmd.IfFlag != 0 && !lastCmd {
+			continue
 		}
 
 		// Process the command based on its type
@@ -48,35 +55,61 @@ func (w *World) resetZone(zone *types.Zone) {
 				lastLoadedMob = mob
 				log.Printf("Zone reset: Stored mob %s (VNUM %d) as last loaded mob", mob.Name, cmd.Arg1)
 			}
+			lastCmd = mob != nil
 		case 'O': // Load object
 			// Arg1 = Object VNUM, Arg2 = Max number, Arg3 = Room VNUM
-			w.resetObject(cmd.Arg1, cmd.Arg3, cmd.Arg2)
+			obj := w.resetObject(cmd.Arg1, cmd.Arg3, cmd.Arg2)
+			if obj != nil {
+				lastLoadedObj[cmd.Arg1] = obj
+			}
+			lastCmd = obj != nil
 		case 'G': // Give object to mobile
 			// Use the last loaded mob
 			log.Printf("Zone reset: Giving object VNUM %d to last loaded mob", cmd.Arg1)
+			var obj *types.ObjectInstance
 			if lastLoadedMob != nil {
 				log.Printf("Zone reset: Using last loaded mob %s (VNUM %d)", lastLoadedMob.Name, lastLoadedMob.Prototype.VNUM)
-				w.resetGiveObjectToMob(cmd.Arg1, lastLoadedMob)
+				obj = w.resetGiveObjectToMob(cmd.Arg1, lastLoadedMob)
 			} else {
 				log.Printf("Zone reset: No last loaded mob found, falling back to old method")
-				w.resetGiveObject(cmd.Arg1, cmd.Arg2) // Fallback to old method
+				obj = w.resetGiveObject(cmd.Arg1, cmd.Arg2) // Fallback to old method
+			}
+			if obj != nil {
+				lastLoadedObj[cmd.Arg1] = obj
 			}
+			lastCmd = obj != nil
 		case 'E': // Equip mobile with object
 			// Use the last loaded mob
 			log.Printf("Zone reset: Equipping last loaded mob with object VNUM %d in position %d", cmd.Arg1, cmd.Arg3)
+			var obj *types.ObjectInstance
 			if lastLoadedMob != nil {
 				log.Printf("Zone reset: Using last loaded mob %s (VNUM %d)", lastLoadedMob.Name, lastLoadedMob.Prototype.VNUM)
-				w.resetEquipObjectToMob(cmd.Arg1, lastLoadedMob, cmd.Arg3)
+				obj = w.resetEquipObjectToMob(cmd.Arg1, lastLoadedMob, cmd.Arg3)
 			} else {
 				log.Printf("Zone reset: No last loaded mob found, falling back to old method")
-				w.resetEquipObject(cmd.Arg1, cmd.Arg2, cmd.Arg3) // Fallback to old method
+				obj = w.resetEquipObject(cmd.Arg1, cmd.Arg2, cmd.Arg3) // Fallback to old method
 			}
+			if obj != nil {
+				lastLoadedObj[cmd.Arg1] = obj
+			}
+			lastCmd = obj != nil
 		case 'P': // Put object in container
-			w.resetPutObject(cmd.Arg1, cmd.Arg2, cmd.Arg3)
+			// Arg1 = Object VNUM, Arg2 = Max number, Arg3 = Container VNUM
+			container := lastLoadedObj[cmd.Arg3]
+			if container == nil {
+				container = w.findObjectInstance(cmd.Arg3)
+			}
+			obj := w.resetPutObject(cmd.Arg1, cmd.Arg2, container)
+			if obj != nil {
+				lastLoadedObj[cmd.Arg1] = obj
+			}
+			lastCmd = obj != nil
 		case 'D': // Set door state
 			w.resetDoor(cmd.Arg1, cmd.Arg2, cmd.Arg3)
+			lastCmd = true
 		case 'R': // Remove object
 			w.resetRemoveObject(cmd.Arg1, cmd.Arg2)
+			lastCmd = true
 		}
 	}
 }
@@ -179,20 +212,20 @@ func (w *World) resetMobile(mobVnum, roomVnum, maxExisting int) *types.Character
 	return mob
 }
 
-// resetObject loads an object into a room
-func (w *World) resetObject(objVnum, roomVnum, maxExisting int) {
+// resetObject loads an object into a room and returns the created object
+func (w *World) resetObject(objVnum, roomVnum, maxExisting int) *types.ObjectInstance {
 	// Get the object prototype directly (we already have a lock)
 	objProto := w.objects[objVnum]
 	if objProto == nil {
 		log.Printf("Warning: Object %d not found", objVnum)
-		return
+		return nil
 	}
 
 	// Get the room directly (we already have a lock)
 	room := w.rooms[roomVnum]
 	if room == nil {
 		log.Printf("Warning: Room %d not found", roomVnum)
-		return
+		return nil
 	}
 
 	// Count existing objects of this type in the room
@@ -205,14 +238,14 @@ func (w *World) resetObject(objVnum, roomVnum, maxExisting int) {
 
 	// Check if we've reached the maximum number of this object
 	if count >= maxExisting {
-		return
+		return nil
 	}
 
 	// Create a new object instance
 	obj := w.CreateObjectFromPrototype(objVnum)
 	if obj == nil {
 		log.Printf("Warning: Failed to create object %d", objVnum)
-		return
+		return nil
 	}
 
 	// Lock the room before modifying its object list
@@ -227,7 +260,7 @@ func (w *World) resetObject(objVnum, roomVnum, maxExisting int) {
 	}
 	if count >= maxExisting {
 		room.Unlock() // Unlock before returning
-		return
+		return nil
 	}
 
 	// Create object instance (already under world lock)
@@ -236,7 +269,7 @@ func (w *World) resetObject(objVnum, roomVnum, maxExisting int) {
 	if obj == nil {
 		room.Unlock()                                              // Unlock before returning
 		log.Printf("Warning: Failed to create object %d", objVnum) // Log moved here
-		return
+		return nil
 	}
 
 	// Add object to room (under room lock)
@@ -245,28 +278,33 @@ func (w *World) resetObject(objVnum, roomVnum, maxExisting int) {
 
 	room.Unlock() // Unlock the room
 
+	w.UpdateRoomLight(room)
+
 	log.Printf("Loaded object %s (%d) into room %d", obj.Prototype.Name, objVnum, roomVnum)
+
+	return obj
 }
 
-// resetGiveObjectToMob gives an object to a specific mobile instance
-func (w *World) resetGiveObjectToMob(objVnum int, mob *types.Character) {
+// resetGiveObjectToMob gives an object to a specific mobile instance and
+// returns the created object
+func (w *World) resetGiveObjectToMob(objVnum int, mob *types.Character) *types.ObjectInstance {
 	// Get the object prototype directly (we already have a lock)
 	objProto := w.objects[objVnum]
 	if objProto == nil {
 		log.Printf("Warning: Object %d not found", objVnum)
-		return
+		return nil
 	}
 
 	if mob == nil {
 		log.Printf("Warning: Mobile is nil for giving object %d", objVnum)
-		return
+		return nil
 	}
 
 	// Create a new object instance
 	obj := w.CreateObjectFromPrototype(objVnum)
 	if obj == nil {
 		log.Printf("Warning: Failed to create object %d", objVnum)
-		return
+		return nil
 	}
 
 	// Add the object to the mobile's inventory
@@ -274,15 +312,18 @@ func (w *World) resetGiveObjectToMob(objVnum int, mob *types.Character) {
 	mob.Inventory = append(mob.Inventory, obj)
 
 	log.Printf("Gave object %s (%d) to mobile %s (VNUM %d)", obj.Prototype.Name, objVnum, mob.Name, mob.Prototype.VNUM)
+
+	return obj
 }
 
-// resetGiveObject gives an object to a mobile (fallback method)
-func (w *World) resetGiveObject(objVnum, mobVnum int) {
+// resetGiveObject gives an object to a mobile and returns the created object
+// (fallback method)
+func (w *World) resetGiveObject(objVnum, mobVnum int) *types.ObjectInstance {
 	// Get the object prototype directly (we already have a lock)
 	objProto := w.objects[objVnum]
 	if objProto == nil {
 		log.Printf("Warning: Object %d not found", objVnum)
-		return
+		return nil
 	}
 
 	// Find the last loaded mobile of this type
@@ -301,7 +342,7 @@ func (w *World) resetGiveObject(objVnum, mobVnum int) {
 
 	if mob == nil {
 		log.Printf("Warning: Mobile %d not found for giving object %d", mobVnum, objVnum)
-		return
+		return nil
 	}
 
 	log.Printf("Found mob %s (VNUM %d) for giving object %d", mob.Name, mobVnum, objVnum)
@@ -310,7 +351,7 @@ func (w *World) resetGiveObject(objVnum, mobVnum int) {
 	obj := w.CreateObjectFromPrototype(objVnum)
 	if obj == nil {
 		log.Printf("Warning: Failed to create object %d", objVnum)
-		return
+		return nil
 	}
 
 	// Add the object to the mobile's inventory
@@ -318,45 +359,54 @@ func (w *World) resetGiveObject(objVnum, mobVnum int) {
 	mob.Inventory = append(mob.Inventory, obj)
 
 	log.Printf("Gave object %s (%d) to mobile %s (%d)", obj.Prototype.Name, objVnum, mob.Name, mobVnum)
+
+	return obj
 }
 
-// resetEquipObjectToMob equips a specific mobile instance with an object
-func (w *World) resetEquipObjectToMob(objVnum int, mob *types.Character, position int) {
+// resetEquipObjectToMob equips a specific mobile instance with an object and
+// returns the created object
+func (w *World) resetEquipObjectToMob(objVnum int, mob *types.Character, position int) *types.ObjectInstance {
 	// Get the object prototype directly (we already have a lock)
 	objProto := w.objects[objVnum]
 	if objProto == nil {
 		log.Printf("Warning: Object %d not found", objVnum)
-		return
+		return nil
 	}
 
 	if mob == nil {
 		log.Printf("Warning: Mobile is nil for equipping object %d", objVnum)
-		return
+		return nil
 	}
 
 	// Create a new object instance
 	obj := w.CreateObjectFromPrototype(objVnum)
 	if obj == nil {
 		log.Printf("Warning: Failed to create object %d", objVnum)
-		return
+		return nil
 	}
 
 	// Equip the mobile with the object
 	obj.WornBy = mob
 	obj.WornOn = position
 	mob.Equipment[position] = obj
+	if position == types.WEAR_LIGHT {
+		w.UpdateRoomLight(mob.InRoom)
+	}
 
 	log.Printf("Equipped mobile %s (VNUM %d) with object %s (%d) in position %d",
 		mob.Name, mob.Prototype.VNUM, obj.Prototype.Name, objVnum, position)
+
+	return obj
 }
 
-// resetEquipObject equips a mobile with an object (fallback method)
-func (w *World) resetEquipObject(objVnum, mobVnum, position int) {
+// resetEquipObject equips a mobile with an object and returns the created
+// object (fallback method)
+func (w *World) resetEquipObject(objVnum, mobVnum, position int) *types.ObjectInstance {
 	// Get the object prototype directly (we already have a lock)
 	objProto := w.objects[objVnum]
 	if objProto == nil {
 		log.Printf("Warning: Object %d not found", objVnum)
-		return
+		return nil
 	}
 
 	// Find the last loaded mobile of this type
@@ -375,7 +425,7 @@ func (w *World) resetEquipObject(objVnum, mobVnum, position int) {
 
 	if mob == nil {
 		log.Printf("Warning: Mobile %d not found for equipping object %d", mobVnum, objVnum)
-		return
+		return nil
 	}
 
 	log.Printf("Found mob %s (VNUM %d) for equipping object %d", mob.Name, mobVnum, objVnum)
@@ -384,21 +434,118 @@ func (w *World) resetEquipObject(objVnum, mobVnum, position int) {
 	obj := w.CreateObjectFromPrototype(objVnum)
 	if obj == nil {
 		log.Printf("Warning: Failed to create object %d", objVnum)
-		return
+		return nil
 	}
 
 	// Equip the mobile with the object
 	obj.WornBy = mob
 	obj.WornOn = position
 	mob.Equipment[position] = obj
+	if position == types.WEAR_LIGHT {
+		w.UpdateRoomLight(mob.InRoom)
+	}
 
 	log.Printf("Equipped mobile %s (%d) with object %s (%d) in position %d",
 		mob.Name, mobVnum, obj.Prototype.Name, objVnum, position)
+
+	return obj
 }
 
-// resetPutObject puts an object in a container
-func (w *World) resetPutObject(objVnum, containerVnum, maxExisting int) {
-	// TODO: Implement
+// resetPutObject loads an object into a container and returns the created
+// object. Like mobiles, the number of the object in the whole world is
+// limited to maxExisting.
+func (w *World) resetPutObject(objVnum, maxExisting int, container *types.ObjectInstance) *types.ObjectInstance {
+	// Get the object prototype directly (we already have a lock)
+	objProto := w.objects[objVnum]
+	if objProto == nil {
+		log.Printf("Warning: Object %d not found", objVnum)
+		return nil
+	}
+
+	if container == nil {
+		log.Printf("Warning: Container not found for putting object %d", objVnum)
+		return nil
+	}
+
+	// Check if we've reached the maximum number of this object
+	if w.countObjectInstances(objVnum) >= maxExisting {
+		return nil
+	}
+
+	// Create a new object instance
+	obj := w.CreateObjectFromPrototype(objVnum)
+	if obj == nil {
+		log.Printf("Warning: Failed to create object %d", objVnum)
+		return nil
+	}
+
+	// Put the object in the container
+	obj.InObj = container
+	container.Contains = append(container.Contains, obj)
+
+	log.Printf("Put object %s (%d) in container %s (%d)",
+		obj.Prototype.Name, objVnum, container.Prototype.Name, container.Prototype.VNUM)
+
+	return obj
+}
+
+// countObjectInstances counts the instances of an object in the world, on
+// the ground, carried, worn or inside other objects
+func (w *World) countObjectInstances(vnum int) int {
+	count := 0
+	w.forEachObjectInstance(func(obj *types.ObjectInstance) bool {
+		if obj.Prototype != nil && obj.Prototype.VNUM == vnum {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+// findObjectInstance returns an instance of an object somewhere in the
+// world, or nil if there is none
+func (w *World) findObjectInstance(vnum int) *types.ObjectInstance {
+	var found *types.ObjectInstance
+	w.forEachObjectInstance(func(obj *types.ObjectInstance) bool {
+		if obj.Prototype != nil && obj.Prototype.VNUM == vnum {
+			found = obj
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// forEachObjectInstance calls fn for every object in the world until it
+// returns false. The caller must hold the world lock.
+func (w *World) forEachObjectInstance(fn func(obj *types.ObjectInstance) bool) {
+	var walk func(objs []*types.ObjectInstance) bool
+	walk = func(objs []*types.ObjectInstance) bool {
+		for _, obj := range objs {
+			if obj == nil {
+				continue
+			}
+			if !fn(obj) || !walk(obj.Contains) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, room := range w.rooms {
+		room.RLock()
+		more := walk(room.Objects)
+		room.RUnlock()
+		if !more {
+			return
+		}
+	}
+
+	for _, ch := range w.characters {
+		if !walk(ch.Inventory) || !walk(ch.Equipment) {
+			return
+		}
+	}
 }
 
 // resetDoor sets the state of a door following original DikuMUD logic
@@ -508,9 +655,19 @@ func (w *World) CreateObjectFromPrototype(vnum int) *types.ObjectInstance {
 	}
 
 	// Create a new object instance
+	return newObjectInstance(objProto)
+}
+
+// newObjectInstance creates an instance of an object prototype. A container
+// starts out closed, locked or open as its prototype is and keeps its own
+// state from then on.
+func newObjectInstance(objProto *types.Object) *types.ObjectInstance {
 	obj := &types.ObjectInstance{
 		Prototype: objProto,
 	}
+	if objProto.Type == types.ITEM_CONTAINER {
+		obj.SetContainerFlags(objProto.Value[1])
+	}
 
 	return obj
 }
@@ -223,3 +223,163 @@ func TestZoneResetEquipment(t *testing.T) {
 		mob.Name, mob.Prototype.VNUM, mob.Equipment[16].Prototype.Name,
 		mob.Equipment[16].Prototype.VNUM, 16)
 }
+
+func TestZoneResetNestedContainers(t *testing.T) {
+	// Create a mock storage
+	storage := NewMockStorage()
+
+	// Create a test room
+	room := &types.Room{
+		VNUM:        3001,
+		Name:        "Test Room",
+		Description: "This is a test room.",
+		Characters:  make([]*types.Character, 0),
+		Objects:     make([]*types.ObjectInstance, 0),
+	}
+	storage.rooms = append(storage.rooms, room)
+
+	// Create a chest holding a bag holding a key
+	chest := &types.Object{VNUM: 3100, Name: "chest", ShortDesc: "a chest", Type: types.ITEM_CONTAINER}
+	bag := &types.Object{VNUM: 3101, Name: "bag", ShortDesc: "a bag", Type: types.ITEM_CONTAINER}
+	key := &types.Object{VNUM: 3102, Name: "key", ShortDesc: "a key", Type: types.ITEM_KEY}
+	storage.objects = append(storage.objects, chest, bag, key)
+
+	zone := &types.Zone{
+		VNUM: 30,
+		Name: "Test Zone",
+		Commands: []*types.ZoneCommand{
+			{Command: 'O', IfFlag: 0, Arg1: chest.VNUM, Arg2: 1, Arg3: room.VNUM}, // Load the chest
+			{Command: 'P', IfFlag: 1, Arg1: bag.VNUM, Arg2: 1, Arg3: chest.VNUM},  // Put the bag in the chest
+			{Command: 'P', IfFlag: 1, Arg1: key.VNUM, Arg2: 1, Arg3: bag.VNUM},    // Put the key in the bag
+		},
+	}
+	storage.zones = append(storage.zones, zone)
+	room.Zone = zone
+
+	// Create a test world, which resets the zone
+	world, err := NewWorld(nil, storage)
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	if len(room.Objects) != 1 || room.Objects[0].Prototype.VNUM != chest.VNUM {
+		t.Fatalf("Expected the chest in the room, got %d objects", len(room.Objects))
+	}
+	chestObj := room.Objects[0]
+	if len(chestObj.Contains) != 1 || chestObj.Contains[0].Prototype.VNUM != bag.VNUM {
+		t.Fatalf("Expected the bag in the chest, got %d objects", len(chestObj.Contains))
+	}
+	bagObj := chestObj.Contains[0]
+	if bagObj.InObj != chestObj {
+		t.Errorf("Expected the bag to know it is in the chest")
+	}
+	if len(bagObj.Contains) != 1 || bagObj.Contains[0].Prototype.VNUM != key.VNUM {
+		t.Fatalf("Expected the key in the bag, got %d objects", len(bagObj.Contains))
+	}
+	keyObj := bagObj.Contains[0]
+	if keyObj.InObj != bagObj {
+		t.Errorf("Expected the key to know it is in the bag")
+	}
+
+	// The chest is still there, so nothing is loaded again
+	zone.Age = zone.Lifespan
+	world.ResetZones()
+	if len(room.Objects) != 1 || len(chestObj.Contains) != 1 || len(bagObj.Contains) != 1 {
+		t.Errorf("Expected nothing new after a second reset, got %d, %d and %d objects",
+			len(room.Objects), len(chestObj.Contains), len(bagObj.Contains))
+	}
+
+	// Without a new chest the key is not put back, even though it is gone
+	world.ExtractObj(keyObj)
+	zone.Age = zone.Lifespan
+	world.ResetZones()
+	if len(bagObj.Contains) != 0 {
+		t.Errorf("Expected the key to stay gone while the chest was not reloaded, got %d objects", len(bagObj.Contains))
+	}
+}
+
+func TestZoneResetPutObjectWorldCount(t *testing.T) {
+	// Create a mock storage
+	storage := NewMockStorage()
+
+	// Create a test room
+	room := &types.Room{
+		VNUM:        3001,
+		Name:        "Test Room",
+		Description: "This is a test room.",
+		Characters:  make([]*types.Character, 0),
+		Objects:     make([]*types.ObjectInstance, 0),
+	}
+	storage.rooms = append(storage.rooms, room)
+
+	chest := &types.Object{VNUM: 3100, Name: "chest", ShortDesc: "a chest", Type: types.ITEM_CONTAINER}
+	coin := &types.Object{VNUM: 3103, Name: "coin", ShortDesc: "a gold coin", Type: types.ITEM_TREASURE}
+	storage.objects = append(storage.objects, chest, coin)
+
+	zone := &types.Zone{
+		VNUM: 30,
+		Name: "Test Zone",
+		Commands: []*types.ZoneCommand{
+			{Command: 'O', IfFlag: 0, Arg1: chest.VNUM, Arg2: 1, Arg3: room.VNUM}, // Load the chest
+			{Command: 'P', IfFlag: 0, Arg1: coin.VNUM, Arg2: 2, Arg3: chest.VNUM}, // Up to two coins in the chest
+		},
+	}
+	storage.zones = append(storage.zones, zone)
+	room.Zone = zone
+
+	// Create a test world, which resets the zone
+	world, err := NewWorld(nil, storage)
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	chestObj := room.Objects[0]
+	if len(chestObj.Contains) != 1 {
+		t.Fatalf("Expected one coin in the chest, got %d", len(chestObj.Contains))
+	}
+
+	// The coin goes into the chest already in the room
+	zone.Age = zone.Lifespan
+	world.ResetZones()
+	if len(room.Objects) != 1 || len(chestObj.Contains) != 2 {
+		t.Fatalf("Expected a second coin in the same chest, got %d objects and %d coins",
+			len(room.Objects), len(chestObj.Contains))
+	}
+
+	// A coin taken out still counts towards the maximum
+	looter := &types.Character{Name: "Looter", World: world}
+	world.AddCharacter(looter)
+	taken := chestObj.Contains[0]
+	chestObj.Contains = chestObj.Contains[1:]
+	taken.InObj = nil
+	world.ObjectToChar(taken, looter)
+
+	zone.Age = zone.Lifespan
+	world.ResetZones()
+	if len(chestObj.Contains) != 1 {
+		t.Errorf("Expected no new coin while two exist in the world, got %d in the chest", len(chestObj.Contains))
+	}
+}
+
+func TestCreatedContainersKeepTheirOwnFlags(t *testing.T) {
+	storage := NewMockStorage()
+	chest := &types.Object{VNUM: 3100, Name: "chest", Type: types.ITEM_CONTAINER,
+		Value: [4]int{100, types.CONT_CLOSEABLE | types.CONT_CLOSED | types.CONT_LOCKED, 3101, 0}}
+	storage.objects = append(storage.objects, chest)
+
+	world, err := NewWorld(nil, storage)
+	if err != nil {
+		t.Fatalf("Failed to create world: %v", err)
+	}
+
+	obj := world.CreateObjectFromPrototype(chest.VNUM)
+	if obj.ContainerFlags() != chest.Value[1] {
+		t.Fatalf("Expected the chest to start closed and locked, got %d", obj.ContainerFlags())
+	}
+
+	// Opened and emptied of every flag, it doesn't fall back to its prototype
+	obj.SetContainerFlags(0)
+	if obj.ContainerFlags() != 0 {
+		t.Errorf("Expected the chest to stay open, got %d", obj.ContainerFlags())
+	}
+}